@@ -0,0 +1,204 @@
+package component
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/jamestrandung/go-concurrency/v2/async"
+)
+
+// Errors related to background executors.
+var (
+	// ErrBackgroundGroupDraining is given to the error handler of a BackgroundGroup when
+	// a background executor cannot start because the group is being drained.
+	ErrBackgroundGroupDraining = errors.New("background group is draining")
+	// ErrNilBackgroundGroup is returned by ExecutionFlowBuilder.Build, or by the flow
+	// itself if it was not validated, for background executors created without a group.
+	ErrNilBackgroundGroup = errors.New("background executor without group")
+)
+
+// BackgroundErrorHandler handles the errors returned by background executors.
+type BackgroundErrorHandler func(ctx context.Context, executorName string, err error)
+
+type backgroundGroupConfigs struct {
+	errorHandler BackgroundErrorHandler
+}
+
+// BackgroundGroupOption customizes a BackgroundGroup when it gets created.
+type BackgroundGroupOption func(*backgroundGroupConfigs)
+
+// WithErrorHandler sets the handler receiving the errors returned by the background
+// executors in the group. By default or if handler is nil, errors are logged using
+// LoggerFrom.
+func WithErrorHandler(handler BackgroundErrorHandler) BackgroundGroupOption {
+	return func(configs *backgroundGroupConfigs) {
+		if handler == nil {
+			configs.errorHandler = logBackgroundError
+			return
+		}
+
+		configs.errorHandler = handler
+	}
+}
+
+// BackgroundGroup runs background executors outside of the flows that launched
+// them so that services can wait for them to complete, e.g. on shutdown.
+type BackgroundGroup struct {
+	*backgroundGroupConfigs
+	mu       sync.Mutex
+	draining bool
+	wg       sync.WaitGroup
+}
+
+// NewBackgroundGroup returns a new BackgroundGroup.
+func NewBackgroundGroup(options ...BackgroundGroupOption) *BackgroundGroup {
+	configs := &backgroundGroupConfigs{
+		errorHandler: logBackgroundError,
+	}
+
+	for _, o := range options {
+		o(configs)
+	}
+
+	return &BackgroundGroup{
+		backgroundGroupConfigs: configs,
+	}
+}
+
+// Drain stops this group from starting new background executors and blocks until
+// the running ones complete or until ctx is done, in which case ctx.Err() is returned.
+func (g *BackgroundGroup) Drain(ctx context.Context) error {
+	g.mu.Lock()
+	g.draining = true
+	g.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// launch runs the given task in the background & hands over its error to the error handler.
+func (g *BackgroundGroup) launch(ctx context.Context, executorName string, task async.Task[any]) {
+	g.mu.Lock()
+	if g.draining {
+		g.mu.Unlock()
+		g.errorHandler(ctx, executorName, ErrBackgroundGroupDraining)
+
+		return
+	}
+
+	g.wg.Add(1)
+	g.mu.Unlock()
+
+	go func() {
+		defer g.wg.Done()
+
+		if err := errorOf(task.ExecuteSync(ctx)); err != nil {
+			g.errorHandler(ctx, executorName, err)
+		}
+	}()
+}
+
+func logBackgroundError(ctx context.Context, executorName string, err error) {
+	LoggerFrom(ctx).Error("background executor failed", LogKeyExecutor, executorName, "error", err)
+}
+
+// BackgroundExecutor encapsulates the task of a component that gets launched by
+// an execution flow without the flow waiting for it, e.g. to send analytics events
+// or to warm up caches. Its errors go to the error handler of its BackgroundGroup
+// instead of failing the flow. Since it outlives the flow, its execution is not
+// part of the ExecutionReport, spans & metrics of the flow.
+type BackgroundExecutor struct {
+	executorMetadata
+	group *BackgroundGroup
+	task  async.Task[any]
+}
+
+// CreateBackgroundExecutor returns a BackgroundExecutor running the given AsyncComponent
+// in the given group. The result of the component is discarded.
+func CreateBackgroundExecutor[T any](c AsyncComponent[T], group *BackgroundGroup, options ...ExecutorOption) BackgroundExecutor {
+	return newBackgroundExecutor(
+		newExecutorConfigs(c, options),
+		group,
+		func(ctx context.Context) error {
+			_, err := c.Execute(ctx)
+			return err
+		},
+	)
+}
+
+// CreateBackgroundExecutorFromFunc works like CreateBackgroundExecutor for small steps that
+// do not deserve a component of their own. It is not named by default, like other executors
+// created from functions.
+func CreateBackgroundExecutorFromFunc(doFn func(ctx context.Context) error, group *BackgroundGroup, options ...ExecutorOption) BackgroundExecutor {
+	return newBackgroundExecutor(newExecutorConfigs(nil, options), group, doFn)
+}
+
+func newBackgroundExecutor(configs *executorConfigs, group *BackgroundGroup, doFn func(ctx context.Context) error) BackgroundExecutor {
+	return BackgroundExecutor{
+		executorMetadata: executorMetadata{configs},
+		group:            group,
+		task: async.NewTask[any](
+			func(ctx context.Context) (any, error) {
+				return intercept(
+					ctx,
+					PhaseExecuteAsync,
+					withTimeout(
+						configs.executeTimeout, func(ctx context.Context) (any, error) {
+							return nil, doFn(ctx)
+						},
+					),
+				)
+			},
+		),
+	}
+}
+
+func (e BackgroundExecutor) invokeSyncTask(ctx context.Context) error {
+	return nil
+}
+
+func (e BackgroundExecutor) canBeInvokedAsync() bool {
+	return true
+}
+
+func (e BackgroundExecutor) invokeAsyncTask(ctx context.Context) error {
+	if e.group == nil {
+		return ErrNilBackgroundGroup
+	}
+
+	// The task must outlive the flow, which does not wait for it
+	// & does not keep track of what happens after it returns
+	e.group.launch(context.WithoutCancel(withoutFlowScope(ctx)), e.Name(), e.task)
+
+	return nil
+}
+
+// cancel does nothing since background executors must
+// not be affected by the outcome of other executors.
+func (e BackgroundExecutor) cancel(err error) {}
+
+func (e BackgroundExecutor) kind() ExecutorKind {
+	return KindBackground
+}
+
+func (e BackgroundExecutor) phaseTasks() map[Phase]any {
+	return map[Phase]any{
+		PhaseExecuteAsync: e.task,
+	}
+}
+
+// InvokeExecutingTask runs the task of this executor synchronously, outside of its group.
+func (e BackgroundExecutor) InvokeExecutingTask(ctx context.Context) error {
+	return errorOf(e.task.ExecuteSync(ctx))
+}
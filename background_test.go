@@ -0,0 +1,244 @@
+package component
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type recordedBackgroundError struct {
+	executorName string
+	err          error
+}
+
+// backgroundErrorRecorder is a BackgroundErrorHandler keeping track of the errors it received.
+type backgroundErrorRecorder struct {
+	mu     sync.Mutex
+	errors []recordedBackgroundError
+}
+
+func (r *backgroundErrorRecorder) handle(ctx context.Context, executorName string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.errors = append(r.errors, recordedBackgroundError{executorName, err})
+}
+
+func (r *backgroundErrorRecorder) recorded() []recordedBackgroundError {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]recordedBackgroundError(nil), r.errors...)
+}
+
+func TestBackgroundExecutor(t *testing.T) {
+	scenarios := []struct {
+		desc string
+		test func(t *testing.T)
+	}{
+		{
+			desc: "flow does not wait for background executors",
+			test: func(t *testing.T) {
+				group := NewBackgroundGroup()
+				release := make(chan struct{})
+
+				var completed bool
+				background := CreateBackgroundExecutorFromFunc(
+					func(ctx context.Context) error {
+						<-release
+						completed = true
+
+						return nil
+					},
+					group,
+					WithName("analytics"),
+				)
+
+				flow := NewExecutionFlowBuilder().
+					Append(
+						background,
+						CreateSyncOrchestratingExecutor(func(ctx context.Context) error { return nil }),
+					).
+					Get()
+
+				ctx, cancel := context.WithCancel(context.Background())
+
+				report, err := ForkJoinFailingFastWithReport(ctx, flow)
+				assert.Nil(t, err)
+				assert.Equal(t, KindBackground, report.Executors[0].Kind)
+				assert.NotEqual(t, StatusSucceeded, report.Executors[0].Status)
+
+				// Background executors outlive the context of the flow
+				cancel()
+				close(release)
+
+				assert.Nil(t, group.Drain(context.Background()))
+				assert.True(t, completed)
+			},
+		},
+		{
+			desc: "errors go to the error handler instead of the caller",
+			test: func(t *testing.T) {
+				recorder := &backgroundErrorRecorder{}
+				group := NewBackgroundGroup(WithErrorHandler(recorder.handle))
+
+				flow := NewExecutionFlowBuilder().
+					Append(
+						CreateBackgroundExecutor[int](
+							asyncComponentFunc[int](
+								func(ctx context.Context) (int, error) {
+									return 0, assert.AnError
+								},
+							),
+							group,
+							WithName("cache_warm_up"),
+						),
+					).
+					Get()
+
+				assert.Nil(t, ForkJoinFailingFast(context.Background(), flow))
+				assert.Nil(t, group.Drain(context.Background()))
+
+				assert.Equal(t, []recordedBackgroundError{{"cache_warm_up", assert.AnError}}, recorder.recorded())
+			},
+		},
+		{
+			desc: "background executors are not cancelled when the flow fails",
+			test: func(t *testing.T) {
+				recorder := &backgroundErrorRecorder{}
+				group := NewBackgroundGroup(WithErrorHandler(recorder.handle))
+
+				flow := NewExecutionFlowBuilder().
+					Append(
+						CreateBackgroundExecutorFromFunc(
+							func(ctx context.Context) error {
+								select {
+								case <-time.After(20 * time.Millisecond):
+									return nil
+								case <-ctx.Done():
+									return ctx.Err()
+								}
+							},
+							group,
+						),
+						CreateSyncOrchestratingExecutor(func(ctx context.Context) error { return assert.AnError }),
+					).
+					Get()
+
+				assert.Equal(t, &ExecutorError{Executor: "executor 0-1", Err: assert.AnError}, ForkJoinFailingFast(context.Background(), flow))
+				assert.Nil(t, group.Drain(context.Background()))
+				assert.Empty(t, recorder.recorded())
+			},
+		},
+		{
+			desc: "background executors are not attributed to the flow",
+			test: func(t *testing.T) {
+				type key struct{}
+
+				recorder := tracetest.NewSpanRecorder()
+				provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+				group := NewBackgroundGroup()
+
+				var (
+					value    any
+					hasScope bool
+					hasSpan  bool
+				)
+
+				flow := NewExecutionFlowBuilder().
+					Append(
+						CreateBackgroundExecutorFromFunc(
+							func(ctx context.Context) error {
+								value = ctx.Value(key{})
+								_, hasScope = invocationScopeFrom(ctx)
+								hasSpan = trace.SpanContextFromContext(ctx).IsValid()
+
+								return nil
+							},
+							group,
+						),
+					).
+					WithTracerProvider(provider).
+					Get()
+
+				report, err := ForkJoinFailingFastWithReport(context.WithValue(context.Background(), key{}, "value"), flow)
+				assert.Nil(t, err)
+				assert.Nil(t, group.Drain(context.Background()))
+
+				assert.Equal(t, "value", value, "values of the flow context are kept")
+				assert.False(t, hasScope)
+				assert.False(t, hasSpan)
+				assert.Equal(t, StatusNotStarted, report.Executors[0].Execute.Status)
+			},
+		},
+		{
+			desc: "background executors without group fail the flow",
+			test: func(t *testing.T) {
+				flow := NewExecutionFlowBuilder().
+					Append(CreateBackgroundExecutorFromFunc(func(ctx context.Context) error { return nil }, nil)).
+					Get()
+
+				assert.ErrorIs(t, ForkJoinFailingFast(context.Background(), flow), ErrNilBackgroundGroup)
+			},
+		},
+		{
+			desc: "errors are logged if the error handler is nil",
+			test: func(t *testing.T) {
+				group := NewBackgroundGroup(WithErrorHandler(nil))
+				assert.NotNil(t, group.errorHandler)
+
+				flow := NewExecutionFlowBuilder().
+					Append(CreateBackgroundExecutorFromFunc(func(ctx context.Context) error { return assert.AnError }, group)).
+					Get()
+
+				assert.Nil(t, ForkJoinFailingFast(context.Background(), flow))
+				assert.Nil(t, group.Drain(context.Background()))
+			},
+		},
+		{
+			desc: "draining group",
+			test: func(t *testing.T) {
+				recorder := &backgroundErrorRecorder{}
+				group := NewBackgroundGroup(WithErrorHandler(recorder.handle))
+				release := make(chan struct{})
+
+				flow := NewExecutionFlowBuilder().
+					Append(
+						CreateBackgroundExecutorFromFunc(
+							func(ctx context.Context) error {
+								<-release
+								return nil
+							},
+							group,
+						),
+					).
+					Get()
+
+				assert.Nil(t, ForkJoinFailingFast(context.Background(), flow))
+
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+				defer cancel()
+
+				assert.Equal(t, context.DeadlineExceeded, group.Drain(ctx))
+
+				late := CreateBackgroundExecutorFromFunc(func(ctx context.Context) error { return nil }, group, WithName("late"))
+				assert.Nil(t, ForkJoinFailingFast(context.Background(), NewExecutionFlowBuilder().Append(late).Get()))
+				assert.Equal(t, []recordedBackgroundError{{"late", ErrBackgroundGroupDraining}}, recorder.recorded())
+
+				close(release)
+				assert.Nil(t, group.Drain(context.Background()))
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(sc.desc, sc.test)
+	}
+}
@@ -0,0 +1,230 @@
+package component
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+const (
+	defaultBatchLoaderMaxBatchSize = 100
+	defaultBatchLoaderWindow       = 2 * time.Millisecond
+)
+
+// ErrBatchKeyNotFound is returned to a caller of BatchLoader
+// when the batched call did not return a value for its key.
+var ErrBatchKeyNotFound = errors.New("key not found in batch result")
+
+type batchLoaderConfigs struct {
+	maxBatchSize int
+	window       time.Duration
+}
+
+// BatchLoaderOption customizes a BatchLoader when it gets created.
+type BatchLoaderOption func(*batchLoaderConfigs)
+
+// WithMaxBatchSize sets the number of distinct keys at which BatchLoader will
+// dispatch the pending batch immediately without waiting for the window to
+// elapse. If `maxBatchSize <= 0`, the default value will be used.
+func WithMaxBatchSize(maxBatchSize int) BatchLoaderOption {
+	return func(configs *batchLoaderConfigs) {
+		if maxBatchSize <= 0 {
+			configs.maxBatchSize = defaultBatchLoaderMaxBatchSize
+			return
+		}
+
+		configs.maxBatchSize = maxBatchSize
+	}
+}
+
+// WithBatchWindow sets how long BatchLoader will keep collecting keys after
+// the first key of a batch arrives. If `window <= 0`, the default value will
+// be used.
+func WithBatchWindow(window time.Duration) BatchLoaderOption {
+	return func(configs *batchLoaderConfigs) {
+		if window <= 0 {
+			configs.window = defaultBatchLoaderWindow
+			return
+		}
+
+		configs.window = window
+	}
+}
+
+// BatchLoader collects the keys requested by concurrent execution flows within
+// a small time window, or up to a max batch size, and loads them using a single
+// batched call to the underlying dependency.
+//
+// It is meant to be called from the Load method of SyncComponentWithLoading so
+// that the result of each key gets delivered to the LoadData of its caller.
+type BatchLoader[K comparable, V any] struct {
+	*batchLoaderConfigs
+
+	mu      sync.Mutex
+	batchFn func(context.Context, []K) (map[K]V, error)
+	pending *loaderBatch[K, V]
+}
+
+type loaderBatch[K comparable, V any] struct {
+	keys       []K
+	keySet     map[K]struct{}
+	waiters    int
+	dispatched bool
+	timer      *time.Timer
+	ctx        context.Context
+	cancel     context.CancelFunc
+	done       chan struct{}
+	results    map[K]V
+	err        error
+}
+
+// NewBatchLoader returns a new BatchLoader using the given batchFn to
+// load all keys in a batch. The returned map may leave out keys having
+// no values, in which case ErrBatchKeyNotFound will be returned.
+func NewBatchLoader[K comparable, V any](
+	batchFn func(ctx context.Context, keys []K) (map[K]V, error),
+	options ...BatchLoaderOption,
+) *BatchLoader[K, V] {
+	configs := &batchLoaderConfigs{
+		maxBatchSize: defaultBatchLoaderMaxBatchSize,
+		window:       defaultBatchLoaderWindow,
+	}
+
+	for _, o := range options {
+		o(configs)
+	}
+
+	return &BatchLoader[K, V]{
+		batchLoaderConfigs: configs,
+		batchFn:            batchFn,
+	}
+}
+
+// Load adds the given key to the pending batch and blocks until the batch
+// has been loaded or the given context is done, whichever happens first.
+func (l *BatchLoader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	b := l.enqueue(ctx, key)
+
+	select {
+	case <-b.done:
+		if b.err != nil {
+			var temp V
+			return temp, b.err
+		}
+
+		v, ok := b.results[key]
+		if !ok {
+			var temp V
+			return temp, fmt.Errorf("%w: %v", ErrBatchKeyNotFound, key)
+		}
+
+		return v, nil
+	case <-ctx.Done():
+		l.leave(b)
+
+		var temp V
+		return temp, ctx.Err()
+	}
+}
+
+func (l *BatchLoader[K, V]) enqueue(ctx context.Context, key K) *loaderBatch[K, V] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b := l.pending
+	if b == nil {
+		// The batched call serves all callers, it must not be
+		// tied to the cancellation of the first one.
+		batchCtx, cancel := context.WithCancel(sharedContext(ctx))
+
+		b = &loaderBatch[K, V]{
+			keySet: make(map[K]struct{}),
+			ctx:    batchCtx,
+			cancel: cancel,
+			done:   make(chan struct{}),
+		}
+
+		b.timer = time.AfterFunc(
+			l.window,
+			func() {
+				l.mu.Lock()
+				defer l.mu.Unlock()
+
+				l.dispatch(b)
+			},
+		)
+
+		l.pending = b
+	}
+
+	b.waiters = b.waiters + 1
+
+	if _, ok := b.keySet[key]; !ok {
+		b.keySet[key] = struct{}{}
+		b.keys = append(b.keys, key)
+	}
+
+	if len(b.keys) >= l.maxBatchSize {
+		b.timer.Stop()
+		l.dispatch(b)
+	}
+
+	return b
+}
+
+// dispatch starts loading the given batch. Callers must hold the lock.
+func (l *BatchLoader[K, V]) dispatch(b *loaderBatch[K, V]) {
+	if b.dispatched {
+		return
+	}
+
+	b.dispatched = true
+
+	if l.pending == b {
+		l.pending = nil
+	}
+
+	go l.load(b)
+}
+
+func (l *BatchLoader[K, V]) load(b *loaderBatch[K, V]) {
+	defer b.cancel()
+
+	defer func() {
+		if r := recover(); r != nil {
+			b.err = fmt.Errorf("panic executing batch loading: %v \n %s", r, debug.Stack())
+		}
+
+		close(b.done)
+	}()
+
+	b.results, b.err = l.batchFn(b.ctx, b.keys)
+}
+
+// leave removes a waiter from the given batch and cancels the
+// batched call if nobody is waiting for it anymore.
+func (l *BatchLoader[K, V]) leave(b *loaderBatch[K, V]) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b.waiters = b.waiters - 1
+	if b.waiters > 0 {
+		return
+	}
+
+	if !b.dispatched {
+		b.timer.Stop()
+		b.dispatched = true
+
+		if l.pending == b {
+			l.pending = nil
+		}
+
+		close(b.done)
+	}
+
+	b.cancel()
+}
@@ -0,0 +1,159 @@
+package component
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchLoader_Load(t *testing.T) {
+	scenarios := []struct {
+		desc string
+		test func(t *testing.T)
+	}{
+		{
+			desc: "keys requested within the window are loaded in one batch",
+			test: func(t *testing.T) {
+				var mu sync.Mutex
+				var batches [][]int
+
+				l := NewBatchLoader(
+					func(ctx context.Context, keys []int) (map[int]string, error) {
+						mu.Lock()
+						defer mu.Unlock()
+
+						batches = append(batches, keys)
+
+						result := make(map[int]string, len(keys))
+						for _, k := range keys {
+							if k != 3 {
+								result[k] = string(rune('a' + k))
+							}
+						}
+
+						return result, nil
+					},
+					WithBatchWindow(50*time.Millisecond),
+				)
+
+				keys := []int{0, 1, 1, 2, 3}
+				values := make([]string, len(keys))
+				errs := make([]error, len(keys))
+
+				var wg sync.WaitGroup
+				for i := range keys {
+					wg.Add(1)
+
+					idx := i
+					go func() {
+						defer wg.Done()
+
+						values[idx], errs[idx] = l.Load(context.Background(), keys[idx])
+					}()
+				}
+
+				wg.Wait()
+
+				assert.Equal(t, 1, len(batches))
+				assert.ElementsMatch(t, []int{0, 1, 2, 3}, batches[0], "duplicate keys must be loaded once")
+				assert.Equal(t, []string{"a", "b", "b", "c", ""}, values)
+				assert.Equal(t, []error{nil, nil, nil, nil}, errs[:4])
+				assert.True(t, errors.Is(errs[4], ErrBatchKeyNotFound))
+			},
+		},
+		{
+			desc: "batch is dispatched right away when reaching max batch size",
+			test: func(t *testing.T) {
+				batchSizes := make(chan int, 2)
+
+				l := NewBatchLoader(
+					func(ctx context.Context, keys []int) (map[int]int, error) {
+						batchSizes <- len(keys)
+						return map[int]int{}, nil
+					},
+					WithMaxBatchSize(2),
+					WithBatchWindow(time.Hour),
+				)
+
+				var wg sync.WaitGroup
+				for i := 0; i < 2; i++ {
+					wg.Add(1)
+
+					key := i
+					go func() {
+						defer wg.Done()
+
+						l.Load(context.Background(), key)
+					}()
+				}
+
+				wg.Wait()
+
+				assert.Equal(t, 2, <-batchSizes)
+			},
+		},
+		{
+			desc: "error from batch call is delivered to every caller",
+			test: func(t *testing.T) {
+				l := NewBatchLoader(
+					func(ctx context.Context, keys []int) (map[int]int, error) {
+						return nil, assert.AnError
+					},
+				)
+
+				_, err := l.Load(context.Background(), 1)
+				assert.Equal(t, assert.AnError, err)
+			},
+		},
+		{
+			desc: "panic from batch call is converted into an error",
+			test: func(t *testing.T) {
+				l := NewBatchLoader(
+					func(ctx context.Context, keys []int) (map[int]int, error) {
+						panic("boom")
+					},
+				)
+
+				_, err := l.Load(context.Background(), 1)
+				assert.ErrorContains(t, err, "panic executing batch loading: boom")
+			},
+		},
+		{
+			desc: "caller giving up before dispatch stops the batch",
+			test: func(t *testing.T) {
+				isCalled := false
+
+				l := NewBatchLoader(
+					func(ctx context.Context, keys []int) (map[int]int, error) {
+						isCalled = true
+						return nil, nil
+					},
+					WithBatchWindow(50*time.Millisecond),
+				)
+
+				ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+				defer cancel()
+
+				_, err := l.Load(ctx, 1)
+				assert.Equal(t, context.DeadlineExceeded, err)
+
+				<-time.After(100 * time.Millisecond)
+
+				l.mu.Lock()
+				defer l.mu.Unlock()
+
+				assert.False(t, isCalled)
+				assert.Nil(t, l.pending)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(sc.desc, sc.test)
+	}
+}
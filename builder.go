@@ -1,15 +1,55 @@
 package component
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
 // ExecutionFlow ...
 type ExecutionFlow struct {
-	Executors [][]IExecutor
+	Executors      [][]IExecutor
+	name           string
+	scheduler      Scheduler
+	maxConcurrency int
+	interceptors   []Interceptor
+	tracerProvider trace.TracerProvider
+	metrics        *MetricsCollector
+	logging        *flowLogging
+	finally        []FinallyExecutor
 }
 
-// Cancel cancels all executors from the given layer down.
+// spawner returns the function for starting goroutines in one execution of this flow.
+func (f ExecutionFlow) spawner(ctx context.Context) spawnFunc {
+	var scheduler Scheduler = GoroutineScheduler{}
+	if f.scheduler != nil {
+		scheduler = f.scheduler
+	}
+
+	spawn := func(fn func()) error {
+		return scheduler.Go(ctx, fn)
+	}
+
+	if f.maxConcurrency > 0 {
+		// Flow-level jobs wait in an unbounded queue since
+		// their number is limited by the size of the flow.
+		return newBoundedSpawner(f.maxConcurrency, -1, spawn).submit
+	}
+
+	return spawn
+}
+
+// Cancel cancels all executors from the given layer down. The
+// reason given to these executors wraps both ErrCancelled & err.
 func (f ExecutionFlow) Cancel(firstLayerIdx int, err error) {
+	reason := cancelReason(err)
+
 	for i := firstLayerIdx; i < len(f.Executors); i++ {
 		for j := 0; j < len(f.Executors[i]); j++ {
-			f.Executors[i][j].cancel(err)
+			f.Executors[i][j].cancel(reason)
 		}
 	}
 }
@@ -17,6 +57,14 @@ func (f ExecutionFlow) Cancel(firstLayerIdx int, err error) {
 // ExecutionFlowBuilder ...
 type ExecutionFlowBuilder struct {
 	executorLayers [][]IExecutor
+	name           string
+	scheduler      Scheduler
+	maxConcurrency int
+	interceptors   []Interceptor
+	tracerProvider trace.TracerProvider
+	metrics        *MetricsCollector
+	logging        *flowLogging
+	finally        []FinallyExecutor
 }
 
 // NewExecutionFlowBuilder ...
@@ -41,9 +89,209 @@ func (b *ExecutionFlowBuilder) NextLayer() *ExecutionFlowBuilder {
 	return b
 }
 
-// Get returns the current flow.
+// WithName sets the name identifying the flow in logs, reports, diagrams & metrics.
+func (b *ExecutionFlowBuilder) WithName(name string) *ExecutionFlowBuilder {
+	b.name = name
+
+	return b
+}
+
+// WithScheduler makes the flow start its goroutines using the given Scheduler.
+func (b *ExecutionFlowBuilder) WithScheduler(scheduler Scheduler) *ExecutionFlowBuilder {
+	b.scheduler = scheduler
+
+	return b
+}
+
+// WithWorkerPool makes the flow execute its components on the given pool,
+// which is typically shared by all flows of an application.
+func (b *ExecutionFlowBuilder) WithWorkerPool(pool *WorkerPool) *ExecutionFlowBuilder {
+	return b.WithScheduler(pool)
+}
+
+// WithMaxConcurrency limits how many goroutines the flow can use at the same
+// time to execute its components. If `maxConcurrency <= 0`, there's no limit.
+func (b *ExecutionFlowBuilder) WithMaxConcurrency(maxConcurrency int) *ExecutionFlowBuilder {
+	b.maxConcurrency = maxConcurrency
+
+	return b
+}
+
+// WithInterceptors registers the given interceptors to run around
+// the executors of this flow after the global interceptors.
+func (b *ExecutionFlowBuilder) WithInterceptors(interceptors ...Interceptor) *ExecutionFlowBuilder {
+	b.interceptors = append(b.interceptors, interceptors...)
+
+	return b
+}
+
+// WithTracerProvider makes the flow create its spans using the given provider.
+// Flows without a tracer provider do not create any spans.
+func (b *ExecutionFlowBuilder) WithTracerProvider(provider trace.TracerProvider) *ExecutionFlowBuilder {
+	b.tracerProvider = provider
+
+	return b
+}
+
+// WithMetricsCollector makes the flow report its metrics to the given collector.
+func (b *ExecutionFlowBuilder) WithMetricsCollector(collector *MetricsCollector) *ExecutionFlowBuilder {
+	b.metrics = collector
+
+	return b
+}
+
+// WithLogger makes the flow log the lifecycle events of its executors using the
+// given logger, or slog.Default() if it's nil. Components can access a logger
+// tagged with the executor being executed using LoggerFrom.
+func (b *ExecutionFlowBuilder) WithLogger(logger *slog.Logger, options ...LoggingOption) *ExecutionFlowBuilder {
+	b.logging = newFlowLogging(logger, options)
+
+	return b
+}
+
+// Finally registers the given executors to run after the flow completes, whether it
+// succeeded, failed or got cancelled, even if the flow has no executors. They run
+// sequentially in the order they were registered, once all executors of the flow
+// have returned. ForkJoinFailingFast does not wait for them.
+func (b *ExecutionFlowBuilder) Finally(executors ...FinallyExecutor) *ExecutionFlowBuilder {
+	b.finally = append(b.finally, executors...)
+
+	return b
+}
+
+// Various mistakes detected when building a flow.
+var (
+	ErrNilExecutor           = errors.New("nil executor")
+	ErrDuplicateExecutor     = errors.New("executor appended more than once")
+	ErrEmptyLayer            = errors.New("empty layer")
+	ErrDuplicateExecutorName = errors.New("duplicate executor name")
+	ErrDuplicateLoaderName   = errors.New("duplicate loader name")
+)
+
+// Build validates & returns the current flow. All mistakes found in the flow are
+// joined in the returned error & can be checked using errors.Is against ErrNilExecutor,
+// ErrDuplicateExecutor, ErrEmptyLayer, ErrDuplicateExecutorName, ErrDuplicateLoaderName
+// and ErrNilBackgroundGroup.
+func (b *ExecutionFlowBuilder) Build() (ExecutionFlow, error) {
+	flow := b.Get()
+
+	if err := validate(flow); err != nil {
+		return ExecutionFlow{}, err
+	}
+
+	return flow, nil
+}
+
+// validate returns the mistakes found in the given flow, if any.
+func validate(flow ExecutionFlow) error {
+	// Flows without executors are valid, e.g. to only run finally executors,
+	// the initial layer of their builder is then left empty
+	if len(flow.Executors) == 1 && len(flow.Executors[0]) == 0 {
+		return nil
+	}
+
+	var errs []error
+
+	type position struct {
+		layerIdx    int
+		executorIdx int
+	}
+
+	positionsByTask := make(map[any]position)
+	positionsByName := make(map[string]position)
+
+	for layerIdx, executors := range flow.Executors {
+		if len(executors) == 0 {
+			errs = append(errs, fmt.Errorf("%w: layer %d has no executors", ErrEmptyLayer, layerIdx))
+			continue
+		}
+
+		for executorIdx, e := range executors {
+			current := position{layerIdx, executorIdx}
+
+			if e == nil || !hasTasks(e) {
+				errs = append(errs, fmt.Errorf("%w: executor %d in layer %d", ErrNilExecutor, executorIdx, layerIdx))
+				continue
+			}
+
+			if l, ok := e.(loadersDeclarer); ok {
+				errs = append(errs, validateLoaders(l.declaredLoaders(), layerIdx, executorIdx)...)
+			}
+
+			if b, ok := e.(BackgroundExecutor); ok && b.group == nil {
+				errs = append(errs, fmt.Errorf("%w: executor %d in layer %d", ErrNilBackgroundGroup, executorIdx, layerIdx))
+			}
+
+			duplicate := false
+			for _, t := range e.phaseTasks() {
+				if t == nil {
+					continue
+				}
+
+				if p, ok := positionsByTask[t]; ok {
+					duplicate = true
+
+					errs = append(
+						errs,
+						fmt.Errorf(
+							"%w: executor %d in layer %d is the same as executor %d in layer %d, its tasks would be executed twice",
+							ErrDuplicateExecutor, executorIdx, layerIdx, p.executorIdx, p.layerIdx,
+						),
+					)
+
+					break
+				}
+
+				positionsByTask[t] = current
+			}
+
+			// Executors named after their component type by default may share names
+			if duplicate || !e.isNameExplicit() {
+				continue
+			}
+
+			if p, ok := positionsByName[e.Name()]; ok {
+				errs = append(
+					errs,
+					fmt.Errorf(
+						"%w: executor %d in layer %d & executor %d in layer %d are both named %q, names given via WithName must be unique",
+						ErrDuplicateExecutorName, executorIdx, layerIdx, p.executorIdx, p.layerIdx, e.Name(),
+					),
+				)
+
+				continue
+			}
+
+			positionsByName[e.Name()] = current
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// hasTasks returns whether the given executor has any tasks, which is
+// not the case for executors not created using the Create functions.
+func hasTasks(e IExecutor) bool {
+	for _, t := range e.phaseTasks() {
+		if t != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Get returns the current flow without validating it.
 func (b *ExecutionFlowBuilder) Get() ExecutionFlow {
 	return ExecutionFlow{
-		Executors: b.executorLayers,
+		Executors:      b.executorLayers,
+		name:           b.name,
+		scheduler:      b.scheduler,
+		maxConcurrency: b.maxConcurrency,
+		interceptors:   b.interceptors,
+		tracerProvider: b.tracerProvider,
+		metrics:        b.metrics,
+		logging:        b.logging,
+		finally:        b.finally,
 	}
 }
@@ -0,0 +1,151 @@
+package component
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecutionFlowBuilder_Build(t *testing.T) {
+	newExecutor := func(options ...ExecutorOption) Executor[any] {
+		return CreateSyncOrchestratingExecutor(
+			func(ctx context.Context) error {
+				return nil
+			},
+			options...,
+		)
+	}
+
+	routing := newExecutor(WithName("routing"))
+
+	scenarios := []struct {
+		desc           string
+		builder        *ExecutionFlowBuilder
+		expectedErrs   []error
+		expectedDetail string
+	}{
+		{
+			desc: "valid flow",
+			builder: NewExecutionFlowBuilder().
+				Append(routing, newExecutor()).
+				NextLayer().
+				Append(newExecutor(WithName("fare")), newExecutor()),
+		},
+		{
+			desc: "executors of the same component type named after it by default",
+			builder: NewExecutionFlowBuilder().
+				Append(executorOf(CreateSyncExecutor[int](&MockSyncComponent[int]{}))).
+				NextLayer().
+				Append(executorOf(CreateSyncExecutor[int](&MockSyncComponent[int]{}))),
+		},
+		{
+			desc: "nil executor",
+			builder: NewExecutionFlowBuilder().
+				Append(routing, nil),
+			expectedErrs:   []error{ErrNilExecutor},
+			expectedDetail: "nil executor: executor 1 in layer 0",
+		},
+		{
+			desc: "executor not created by Create functions",
+			builder: NewExecutionFlowBuilder().
+				Append(Executor[int]{}),
+			expectedErrs:   []error{ErrNilExecutor},
+			expectedDetail: "nil executor: executor 0 in layer 0",
+		},
+		{
+			desc: "duplicate executor",
+			builder: NewExecutionFlowBuilder().
+				Append(routing).
+				NextLayer().
+				Append(routing),
+			expectedErrs:   []error{ErrDuplicateExecutor},
+			expectedDetail: "executor appended more than once: executor 0 in layer 1 is the same as executor 0 in layer 0, its tasks would be executed twice",
+		},
+		{
+			desc: "flow with finally executors only",
+			builder: NewExecutionFlowBuilder().
+				Finally(func(ctx context.Context, err error, report ExecutionReport) error { return nil }),
+		},
+		{
+			desc: "empty layer",
+			builder: NewExecutionFlowBuilder().
+				Append(routing).
+				NextLayer(),
+			expectedErrs:   []error{ErrEmptyLayer},
+			expectedDetail: "empty layer: layer 1 has no executors",
+		},
+		{
+			desc: "duplicate name",
+			builder: NewExecutionFlowBuilder().
+				Append(routing, newExecutor(WithName("routing"))),
+			expectedErrs:   []error{ErrDuplicateExecutorName},
+			expectedDetail: `duplicate executor name: executor 1 in layer 0 & executor 0 in layer 0 are both named "routing", names given via WithName must be unique`,
+		},
+		{
+			desc: "duplicate loader name",
+			builder: NewExecutionFlowBuilder().
+				Append(
+					executorOf(
+						CreateSyncExecutorWithLoaders[string](
+							componentWithLoaders{
+								configs: NewLoader("configs", func(ctx context.Context) (int, error) { return 1, nil }),
+								flags:   NewLoader("configs", func(ctx context.Context) (string, error) { return "", nil }),
+								profile: NewLoader("profile", func(ctx context.Context) (bool, error) { return true, nil }),
+							},
+						),
+					),
+				),
+			expectedErrs:   []error{ErrDuplicateLoaderName},
+			expectedDetail: `duplicate loader name: executor 0 in layer 0 has several loaders named "configs"`,
+		},
+		{
+			desc: "background executor without group",
+			builder: NewExecutionFlowBuilder().
+				Append(routing, CreateBackgroundExecutorFromFunc(func(ctx context.Context) error { return nil }, nil)),
+			expectedErrs:   []error{ErrNilBackgroundGroup},
+			expectedDetail: "background executor without group: executor 1 in layer 0",
+		},
+		{
+			desc: "custom executor without CustomExecutor",
+			builder: NewExecutionFlowBuilder().
+				Append(routing, CreateCustomExecutor(nil)),
+			expectedErrs:   []error{ErrNilExecutor},
+			expectedDetail: "nil executor: executor 1 in layer 0",
+		},
+		{
+			desc: "all mistakes are reported",
+			builder: NewExecutionFlowBuilder().
+				NextLayer().
+				Append(nil, routing, routing, newExecutor(WithName("routing"))),
+			expectedErrs: []error{ErrEmptyLayer, ErrNilExecutor, ErrDuplicateExecutor, ErrDuplicateExecutorName},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(
+			sc.desc, func(t *testing.T) {
+				flow, err := sc.builder.Build()
+
+				if len(sc.expectedErrs) == 0 {
+					assert.Nil(t, err)
+					assert.Equal(t, sc.builder.Get().Executors, flow.Executors)
+					return
+				}
+
+				assert.NotNil(t, err)
+				assert.Nil(t, flow.Executors)
+
+				for _, expectedErr := range sc.expectedErrs {
+					assert.True(t, errors.Is(err, expectedErr), "expected %v in %v", expectedErr, err)
+				}
+
+				if sc.expectedDetail != "" {
+					assert.Equal(t, sc.expectedDetail, err.Error())
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,338 @@
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// chromeTrace is the JSON Object Format of the Chrome Trace Event Format, which
+// can be opened by Perfetto (https://ui.perfetto.dev) & chrome://tracing.
+type chromeTrace struct {
+	TraceEvents     []chromeTraceEvent `json:"traceEvents"`
+	DisplayTimeUnit string             `json:"displayTimeUnit"`
+}
+
+type chromeTraceEvent struct {
+	Name string         `json:"name"`
+	Cat  string         `json:"cat,omitempty"`
+	Ph   string         `json:"ph"`
+	Ts   float64        `json:"ts"`
+	Dur  float64        `json:"dur,omitempty"`
+	Pid  int            `json:"pid"`
+	Tid  int            `json:"tid"`
+	ID   int            `json:"id,omitempty"`
+	Bp   string         `json:"bp,omitempty"`
+	Args map[string]any `json:"args,omitempty"`
+}
+
+// Chrome Trace Event phases used in exported traces.
+const (
+	chromeTracePhaseComplete  = "X"
+	chromeTracePhaseMetadata  = "M"
+	chromeTracePhaseFlowStart = "s"
+	chromeTracePhaseFlowEnd   = "f"
+)
+
+// chromeTracePid is the only process in exported traces, representing the flow execution.
+const chromeTracePid = 1
+
+// WriteChromeTrace writes this report to w in the Chrome Trace Event Format so that the
+// execution of the flow can be visualized in Perfetto or chrome://tracing.
+//
+// Each layer gets a track spanning the executors in this layer, followed by one track for
+// its sync lane & one track for each of its loading tasks & async executors. Times spent
+// blocked waiting for other executors appear inside the track of the waiting executor and
+// are linked to the executor being waited for by flow arrows.
+func (r ExecutionReport) WriteChromeTrace(w io.Writer) error {
+	b := chromeTraceBuilder{
+		report:   r,
+		tids:     make(map[phaseKey]int),
+		nextTid:  1,
+		flowName: labelOrUnnamed(r.FlowName),
+	}
+
+	b.addMetadata(0, "process_name", b.flowName)
+	b.addSlice(b.newTrack("flow"), "flow "+b.flowName, "flow", r.StartedAt, r.EndedAt, withError(nil, r.Err))
+
+	b.addLayers()
+	b.addWaits()
+
+	return json.NewEncoder(w).Encode(
+		chromeTrace{
+			TraceEvents:     b.events,
+			DisplayTimeUnit: "ms",
+		},
+	)
+}
+
+type chromeTraceBuilder struct {
+	report   ExecutionReport
+	flowName string
+	events   []chromeTraceEvent
+	// tids keeps track of the track on which each phase was added
+	tids    map[phaseKey]int
+	nextTid int
+	nextID  int
+}
+
+func (b *chromeTraceBuilder) addLayers() {
+	layers := make(map[int][]ExecutorReport)
+	layerCount := 0
+
+	for _, e := range b.report.Executors {
+		layers[e.LayerIdx] = append(layers[e.LayerIdx], e)
+		if e.LayerIdx+1 > layerCount {
+			layerCount = e.LayerIdx + 1
+		}
+	}
+
+	for layerIdx := 0; layerIdx < layerCount; layerIdx++ {
+		executors := layers[layerIdx]
+
+		layerTid := b.newTrack(fmt.Sprintf("layer %d", layerIdx))
+		syncLaneTid := b.newTrack(fmt.Sprintf("layer %d / sync lane", layerIdx))
+
+		var layerStartedAt, layerEndedAt time.Time
+		for _, e := range executors {
+			tid := syncLaneTid
+			if e.Kind.isAsync() {
+				tid = b.newTrack(fmt.Sprintf("layer %d / %s", layerIdx, displayName(e)))
+			}
+
+			// Executors with loaders report each loader in Loads instead
+			hasLoad := e.Kind.hasLoading() && len(e.Loads) == 0
+			if hasLoad {
+				loadTid := b.newTrack(fmt.Sprintf("layer %d / %s / %s", layerIdx, displayName(e), e.Load.Phase))
+				b.addPhase(loadTid, e, e.Load)
+			}
+
+			if e.Kind == KindCustom && !e.Async.StartedAt.IsZero() {
+				asyncTid := b.newTrack(fmt.Sprintf("layer %d / %s / %s", layerIdx, displayName(e), e.Async.Phase))
+				b.addPhase(asyncTid, e, e.Async)
+			}
+
+			for _, p := range e.Loads {
+				loadTid := b.newTrack(fmt.Sprintf("layer %d / %s / %s", layerIdx, displayName(e), p.Phase))
+				b.addPhase(loadTid, e, p)
+			}
+
+			b.addPhase(tid, e, e.Execute)
+
+			for _, p := range e.phases() {
+				if p.StartedAt.IsZero() {
+					continue
+				}
+
+				if layerStartedAt.IsZero() || p.StartedAt.Before(layerStartedAt) {
+					layerStartedAt = p.StartedAt
+				}
+
+				if endedAt := b.endOf(p); endedAt.After(layerEndedAt) {
+					layerEndedAt = endedAt
+				}
+			}
+		}
+
+		if !layerStartedAt.IsZero() {
+			b.addSlice(layerTid, fmt.Sprintf("layer %d", layerIdx), "layer", layerStartedAt, layerEndedAt, nil)
+		}
+	}
+}
+
+// addWaits adds the waits of each executor inside its track & links them to the executor being waited for.
+func (b *chromeTraceBuilder) addWaits() {
+	for _, e := range b.report.Executors {
+		for _, w := range e.Waits {
+			consumerTid, ok := b.tids[phaseKey{e.LayerIdx, e.ExecutorIdx, w.Phase}]
+			if !ok {
+				continue
+			}
+
+			b.addSlice(
+				consumerTid,
+				"blocked on "+executorLabel(w.ProducerName, w.ProducerLayerIdx, w.ProducerExecutorIdx),
+				"wait",
+				w.StartedAt,
+				w.EndedAt,
+				map[string]any{"producer_phase": string(w.ProducerPhase)},
+			)
+
+			producerTid, ok := b.tids[producerOf(w)]
+			if !ok {
+				continue
+			}
+
+			producer := b.phaseOf(producerOf(w))
+
+			b.nextID++
+
+			// Flow events get bound to the slice enclosing their timestamp, they must
+			// be placed slightly before the end of the slices they are linking.
+			b.events = append(
+				b.events,
+				chromeTraceEvent{
+					Name: "future",
+					Cat:  "wait",
+					Ph:   chromeTracePhaseFlowStart,
+					Ts:   b.justBefore(producer.StartedAt, b.endOf(producer)),
+					Pid:  chromeTracePid,
+					Tid:  producerTid,
+					ID:   b.nextID,
+				},
+				chromeTraceEvent{
+					Name: "future",
+					Cat:  "wait",
+					Ph:   chromeTracePhaseFlowEnd,
+					Ts:   b.justBefore(w.StartedAt, w.EndedAt),
+					Pid:  chromeTracePid,
+					Tid:  consumerTid,
+					ID:   b.nextID,
+					Bp:   "e",
+				},
+			)
+		}
+	}
+}
+
+func (b *chromeTraceBuilder) newTrack(name string) int {
+	tid := b.nextTid
+	b.nextTid++
+
+	b.addMetadata(tid, "thread_name", name)
+	b.events = append(
+		b.events,
+		chromeTraceEvent{
+			Name: "thread_sort_index",
+			Ph:   chromeTracePhaseMetadata,
+			Pid:  chromeTracePid,
+			Tid:  tid,
+			Args: map[string]any{"sort_index": tid},
+		},
+	)
+
+	return tid
+}
+
+func (b *chromeTraceBuilder) addMetadata(tid int, name string, value string) {
+	b.events = append(
+		b.events,
+		chromeTraceEvent{
+			Name: name,
+			Ph:   chromeTracePhaseMetadata,
+			Pid:  chromeTracePid,
+			Tid:  tid,
+			Args: map[string]any{"name": value},
+		},
+	)
+}
+
+func (b *chromeTraceBuilder) addPhase(tid int, e ExecutorReport, p PhaseReport) {
+	if p.StartedAt.IsZero() {
+		return
+	}
+
+	b.tids[phaseKey{e.LayerIdx, e.ExecutorIdx, p.Phase}] = tid
+
+	b.addSlice(
+		tid,
+		fmt.Sprintf("%s %s", displayName(e), p.Phase),
+		string(p.Phase),
+		p.StartedAt,
+		b.endOf(p),
+		withError(
+			map[string]any{
+				"kind":         string(e.Kind),
+				"layer_idx":    e.LayerIdx,
+				"executor_idx": e.ExecutorIdx,
+				"status":       string(p.Status),
+			},
+			p.Err,
+		),
+	)
+}
+
+func (b *chromeTraceBuilder) addSlice(tid int, name string, cat string, startedAt time.Time, endedAt time.Time, args map[string]any) {
+	b.events = append(
+		b.events,
+		chromeTraceEvent{
+			Name: name,
+			Cat:  cat,
+			Ph:   chromeTracePhaseComplete,
+			Ts:   b.timestampOf(startedAt),
+			Dur:  float64(endedAt.Sub(startedAt).Nanoseconds()) / 1e3,
+			Pid:  chromeTracePid,
+			Tid:  tid,
+			Args: args,
+		},
+	)
+}
+
+func (b *chromeTraceBuilder) phaseOf(key phaseKey) PhaseReport {
+	for _, e := range b.report.Executors {
+		if e.LayerIdx != key.layerIdx || e.ExecutorIdx != key.executorIdx {
+			continue
+		}
+
+		for _, p := range e.phases() {
+			if p.Phase == key.phase {
+				return p
+			}
+		}
+	}
+
+	return PhaseReport{}
+}
+
+// endOf returns when the given phase ended. Phases still running when the flow
+// returned are considered to end together with the flow.
+func (b *chromeTraceBuilder) endOf(p PhaseReport) time.Time {
+	if p.EndedAt.IsZero() {
+		return b.report.EndedAt
+	}
+
+	return p.EndedAt
+}
+
+// timestampOf returns the given time in microseconds since the flow started.
+func (b *chromeTraceBuilder) timestampOf(t time.Time) float64 {
+	return float64(t.Sub(b.report.StartedAt).Nanoseconds()) / 1e3
+}
+
+// justBefore returns the timestamp slightly before endedAt but no earlier than startedAt.
+func (b *chromeTraceBuilder) justBefore(startedAt time.Time, endedAt time.Time) float64 {
+	t := endedAt.Add(-time.Microsecond)
+	if t.Before(startedAt) {
+		t = startedAt
+	}
+
+	return b.timestampOf(t)
+}
+
+func displayName(e ExecutorReport) string {
+	return executorLabel(e.Name, e.LayerIdx, e.ExecutorIdx)
+}
+
+// executorLabel returns the given name or the position of the executor if it has no names.
+func executorLabel(name string, layerIdx int, executorIdx int) string {
+	if name != "" {
+		return name
+	}
+
+	return fmt.Sprintf("executor %d-%d", layerIdx, executorIdx)
+}
+
+func withError(args map[string]any, err error) map[string]any {
+	if err == nil {
+		return args
+	}
+
+	if args == nil {
+		args = make(map[string]any)
+	}
+
+	args["error"] = err.Error()
+
+	return args
+}
@@ -0,0 +1,145 @@
+package component
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExecutionReport_WriteChromeTrace(t *testing.T) {
+	scenarios := []struct {
+		desc string
+		test func(t *testing.T)
+	}{
+		{
+			desc: "empty flow",
+			test: func(t *testing.T) {
+				report, _ := ForkJoinFailingFastWithReport(context.Background(), ExecutionFlow{})
+
+				trace := writeChromeTrace(t, report)
+				assert.Equal(t, "ms", trace.DisplayTimeUnit)
+				assert.Equal(t, []string{"flow"}, trackNames(trace))
+			},
+		},
+		{
+			desc: "tracks per layer, sync lane & async executor with flow arrows for waits",
+			test: func(t *testing.T) {
+				routing, routingFuture := CreateAsyncExecutor[int](
+					asyncComponentFunc[int](
+						func(ctx context.Context) (int, error) {
+							<-time.After(10 * time.Millisecond)
+							return 1, nil
+						},
+					),
+					WithName("routing"),
+				)
+
+				mockSyncComponentWithLoading := &MockSyncComponentWithLoading[int, int]{}
+				mockSyncComponentWithLoading.On("Load", mock.Anything).Return(1, nil).Once()
+				mockSyncComponentWithLoading.On("ExecuteSync", mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						_, _ = routingFuture.Get(args.Get(0).(context.Context))
+					}).
+					Return(1, nil).
+					Once()
+
+				fare, _ := CreateSyncExecutorWithLoading[int, int](mockSyncComponentWithLoading, WithName("fare"))
+
+				flow := NewExecutionFlowBuilder().
+					Append(routing, fare).
+					NextLayer().
+					Append(CreateSyncOrchestratingExecutor(func(ctx context.Context) error { return nil })).
+					WithName("fare_calculation").
+					Get()
+
+				report, err := ForkJoinFailingFastWithReport(context.Background(), flow)
+				assert.Nil(t, err)
+
+				trace := writeChromeTrace(t, report)
+
+				expectedTracks := []string{
+					"flow",
+					"layer 0",
+					"layer 0 / sync lane",
+					"layer 0 / routing",
+					"layer 0 / fare / load",
+					"layer 1",
+					"layer 1 / sync lane",
+				}
+				assert.Equal(t, expectedTracks, trackNames(trace))
+
+				slices := make(map[string]chromeTraceEvent)
+				for _, e := range trace.TraceEvents {
+					if e.Ph == chromeTracePhaseComplete {
+						slices[e.Name] = e
+					}
+				}
+
+				assert.Equal(t, 4, slices["routing execute_async"].Tid)
+				assert.Equal(t, 5, slices["fare load"].Tid)
+				assert.Equal(t, 3, slices["fare execute_sync"].Tid)
+				assert.Equal(t, 7, slices["executor 1-0 execute_sync"].Tid)
+				assert.Equal(t, "succeeded", slices["fare execute_sync"].Args["status"])
+
+				blocked := slices["blocked on routing"]
+				assert.Equal(t, 3, blocked.Tid)
+				assert.True(t, blocked.Dur > 0)
+
+				// Fare may also wait for its own loading task
+				arrows := make(map[int][2]int)
+				for _, e := range trace.TraceEvents {
+					arrow := arrows[e.ID]
+
+					switch e.Ph {
+					case chromeTracePhaseFlowStart:
+						arrow[0] = e.Tid
+					case chromeTracePhaseFlowEnd:
+						arrow[1] = e.Tid
+					default:
+						continue
+					}
+
+					arrows[e.ID] = arrow
+				}
+
+				var routingToFare bool
+				for _, arrow := range arrows {
+					routingToFare = routingToFare || arrow == [2]int{4, 3}
+				}
+
+				assert.True(t, routingToFare, "arrow goes from routing to fare")
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(sc.desc, sc.test)
+	}
+}
+
+func writeChromeTrace(t *testing.T, report ExecutionReport) chromeTrace {
+	var buf bytes.Buffer
+	assert.Nil(t, report.WriteChromeTrace(&buf))
+
+	var result chromeTrace
+	assert.Nil(t, json.Unmarshal(buf.Bytes(), &result))
+
+	return result
+}
+
+func trackNames(trace chromeTrace) []string {
+	var result []string
+	for _, e := range trace.TraceEvents {
+		if e.Ph == chromeTracePhaseMetadata && e.Name == "thread_name" {
+			result = append(result, e.Args["name"].(string))
+		}
+	}
+
+	return result
+}
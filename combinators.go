@@ -0,0 +1,97 @@
+package component
+
+import (
+	"context"
+
+	"github.com/jamestrandung/go-concurrency/v2/async"
+)
+
+// Pair holds the results of 2 futures combined by Zip.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Map returns a future deriving its result from the result of the given future
+// using the given function. Derived futures can be given to other components as
+// inputs, like the futures returned together with executors, to avoid writing a
+// dedicated component for simple derivations.
+//
+// Derived futures are computed the first time they are read, they fail with the
+// UpstreamError of the futures they are derived from.
+func Map[T any, R any](f Future[T], fn func(T) R) Future[R] {
+	return Then(
+		f, func(ctx context.Context, result T) (R, error) {
+			return fn(result), nil
+		},
+	)
+}
+
+// Then returns a future resolving to the outcome of the given function, which is
+// called with the result of the given future once it succeeded. Unlike Map, the
+// function may fail.
+func Then[T any, R any](f Future[T], fn func(ctx context.Context, result T) (R, error)) Future[R] {
+	return newDerivedFuture(
+		func(ctx context.Context) (R, error) {
+			result, err := f.Get(ctx)
+			if err != nil {
+				var zero R
+				return zero, err
+			}
+
+			return fn(ctx, result)
+		},
+	)
+}
+
+// Zip returns a future combining the results of the 2 given futures into a Pair.
+func Zip[A any, B any](a Future[A], b Future[B]) Future[Pair[A, B]] {
+	return newDerivedFuture(
+		func(ctx context.Context) (Pair[A, B], error) {
+			first, err := a.Get(ctx)
+			if err != nil {
+				return Pair[A, B]{}, err
+			}
+
+			second, err := b.Get(ctx)
+			if err != nil {
+				return Pair[A, B]{}, err
+			}
+
+			return Pair[A, B]{
+				First:  first,
+				Second: second,
+			}, nil
+		},
+	)
+}
+
+// All returns a future gathering the results of all given futures in the same order.
+// It fails with the error of the first given future that failed.
+func All[T any](futures ...Future[T]) Future[[]T] {
+	return newDerivedFuture(
+		func(ctx context.Context) ([]T, error) {
+			result := make([]T, 0, len(futures))
+
+			for _, f := range futures {
+				r, err := f.Get(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				result = append(result, r)
+			}
+
+			return result, nil
+		},
+	)
+}
+
+func newDerivedFuture[T any](fn func(ctx context.Context) (T, error)) Future[T] {
+	return Future[T]{
+		state: &futureState[T]{
+			task:    async.NewTask[T](fn),
+			derived: true,
+		},
+	}
+}
@@ -0,0 +1,188 @@
+package component
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCombinators(t *testing.T) {
+	resolved := func(result int, err error) Future[int] {
+		e, f := CreateSyncOrchestratingExecutorWithResult(
+			func(ctx context.Context) (int, error) {
+				return result, err
+			},
+			WithName("producer"),
+		)
+
+		_ = e.InvokeExecutingTask(context.Background())
+
+		return f
+	}
+
+	double := func(v int) int {
+		return v * 2
+	}
+
+	scenarios := []struct {
+		desc string
+		test func(t *testing.T)
+	}{
+		{
+			desc: "Map",
+			test: func(t *testing.T) {
+				actual, err := Map(resolved(1, nil), double).Get(context.Background())
+				assert.Equal(t, 2, actual)
+				assert.Nil(t, err)
+
+				_, err = Map(resolved(1, assert.AnError), double).Get(context.Background())
+				assert.Equal(t, &UpstreamError{Producer: "producer", Err: assert.AnError}, err)
+			},
+		},
+		{
+			desc: "Then",
+			test: func(t *testing.T) {
+				calls := 0
+				f := Then(
+					resolved(1, nil), func(ctx context.Context, result int) (string, error) {
+						calls++
+
+						if result > 0 {
+							return "positive", nil
+						}
+
+						return "", assert.AnError
+					},
+				)
+
+				actual, err := f.Get(context.Background())
+				assert.Equal(t, "positive", actual)
+				assert.Nil(t, err)
+
+				actual, err = f.Get(context.Background())
+				assert.Equal(t, "positive", actual)
+				assert.Nil(t, err)
+				assert.Equal(t, 1, calls, "derived futures are computed once")
+
+				_, err = Then(
+					resolved(0, nil), func(ctx context.Context, result int) (string, error) {
+						return "", assert.AnError
+					},
+				).Get(context.Background())
+				assert.Equal(t, assert.AnError, err)
+			},
+		},
+		{
+			desc: "Zip",
+			test: func(t *testing.T) {
+				actual, err := Zip(resolved(1, nil), Map(resolved(1, nil), func(v int) string { return "1" })).Get(context.Background())
+				assert.Equal(t, Pair[int, string]{First: 1, Second: "1"}, actual)
+				assert.Nil(t, err)
+
+				_, err = Zip(resolved(1, nil), resolved(1, assert.AnError)).Get(context.Background())
+				assert.ErrorIs(t, err, assert.AnError)
+			},
+		},
+		{
+			desc: "All",
+			test: func(t *testing.T) {
+				actual, err := All(resolved(1, nil), resolved(2, nil), resolved(3, nil)).Get(context.Background())
+				assert.Equal(t, []int{1, 2, 3}, actual)
+				assert.Nil(t, err)
+
+				actual, err = All[int]().Get(context.Background())
+				assert.Equal(t, []int{}, actual)
+				assert.Nil(t, err)
+
+				_, err = All(resolved(1, nil), resolved(2, assert.AnError)).Get(context.Background())
+				assert.ErrorIs(t, err, assert.AnError)
+			},
+		},
+		{
+			desc: "derived futures are computed once needed & resolve with the original futures",
+			test: func(t *testing.T) {
+				e, f := CreateAsyncExecutor[int](
+					asyncComponentFunc[int](
+						func(ctx context.Context) (int, error) {
+							return 1, nil
+						},
+					),
+				)
+
+				derived := Map(f, double)
+				assert.False(t, derived.IsResolved())
+				assert.Nil(t, derived.Err())
+
+				done := derived.Done()
+				go e.InvokeExecutingTask(context.Background())
+
+				select {
+				case <-done:
+				case <-time.After(time.Second):
+					assert.Fail(t, "derived future is not done after the original future resolved")
+				}
+
+				assert.True(t, derived.IsResolved())
+				assert.Equal(t, 2, derived.GetOrDefault(context.Background(), 0))
+			},
+		},
+		{
+			desc: "derived futures can be used as inputs of other components",
+			test: func(t *testing.T) {
+				routing, routingFuture := CreateAsyncExecutor[float64](
+					asyncComponentFunc[float64](
+						func(ctx context.Context) (float64, error) {
+							<-time.After(10 * time.Millisecond)
+							return 4, nil
+						},
+					),
+					WithName("routing"),
+				)
+
+				fare, fareFuture := CreateSyncOrchestratingExecutorWithResult(
+					func(ctx context.Context) (float64, error) {
+						return 10, nil
+					},
+					WithName("fare"),
+				)
+
+				farePerKM := Map(
+					Zip(fareFuture, routingFuture), func(p Pair[float64, float64]) float64 {
+						return p.First / p.Second
+					},
+				)
+
+				var actual float64
+				reporting := CreateSyncOrchestratingExecutor(
+					func(ctx context.Context) error {
+						v, err := farePerKM.Get(ctx)
+						actual = v
+
+						return err
+					},
+					WithName("reporting"),
+				)
+
+				flow := NewExecutionFlowBuilder().
+					Append(routing, fare, reporting).
+					Get()
+
+				report, err := ForkJoinFailingFastWithReport(context.Background(), flow)
+				assert.Nil(t, err)
+				assert.Equal(t, 2.5, actual)
+
+				reportingReport := report.Executors[2]
+				if assert.Len(t, reportingReport.Waits, 1) {
+					assert.Equal(t, "routing", reportingReport.Waits[0].ProducerName, "waits for original futures are attributed to the consumer")
+				}
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(sc.desc, sc.test)
+	}
+}
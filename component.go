@@ -49,83 +49,230 @@ type SyncComponentWithLoading[V any, T any] interface {
 	ExecuteSync(ctx context.Context, data LoadData[V]) (T, error)
 }
 
+// AsyncComponentWithLoading represents those components that can be executed
+// concurrently together with other AsyncComponent, like AsyncComponent, but
+// need to perform some loading before executing the main logic, like
+// SyncComponentWithLoading.
+//
+// The loading task starts as soon as the flow starts. The main logic gets
+// executed once both the loading task & the executors declared via DependsOn
+// complete.
+//
+//go:generate mockery --name AsyncComponentWithLoading --case underscore --inpackage
+type AsyncComponentWithLoading[V any, T any] interface {
+	Load(ctx context.Context) (V, error)
+	Execute(ctx context.Context, data LoadData[V]) (T, error)
+}
+
 // CreateSyncExecutor returns an Executor encapsulating the executing
-// task that would be handled by the given SyncComponent.
-func CreateSyncExecutor[T any](c SyncComponent[T]) Executor[T] {
-	return Executor[T]{
-		executingSyncTask: async.NewTask[T](
-			func(ctx context.Context) (T, error) {
-				return c.ExecuteSync(ctx)
-			},
-		),
-	}
+// task that would be handled by the given SyncComponent, together with
+// the Future of its result.
+func CreateSyncExecutor[T any](c SyncComponent[T], options ...ExecutorOption) (Executor[T], Future[T]) {
+	return newSyncExecutor(newExecutorConfigs(c, options), c.ExecuteSync)
 }
 
 // CreateAsyncExecutor returns an Executor encapsulating the executing
-// task that would be handled by the given AsyncComponent.
-func CreateAsyncExecutor[T any](c AsyncComponent[T]) Executor[T] {
-	return Executor[T]{
-		executingAsyncTask: async.NewTask[T](
-			func(ctx context.Context) (T, error) {
-				return c.Execute(ctx)
-			},
-		),
-	}
+// task that would be handled by the given AsyncComponent, together
+// with the Future of its result.
+func CreateAsyncExecutor[T any](c AsyncComponent[T], options ...ExecutorOption) (Executor[T], Future[T]) {
+	return newAsyncExecutor(newExecutorConfigs(c, options), c.Execute)
 }
 
 // CreateSyncExecutorWithLoading returns an ExecutorWithLoading encapsulating the
-// loading & executing tasks that would be handled by the given component.
-func CreateSyncExecutorWithLoading[V any, T any](c SyncComponentWithLoading[V, T]) ExecutorWithLoading[V, T] {
-	loadingTask := async.NewTask[V](
-		func(ctx context.Context) (V, error) {
-			return c.Load(ctx)
+// loading & executing tasks that would be handled by the given component, together
+// with the Future of its result.
+func CreateSyncExecutorWithLoading[V any, T any](c SyncComponentWithLoading[V, T], options ...ExecutorOption) (ExecutorWithLoading[V, T], Future[T]) {
+	return newSyncExecutorWithLoading(newExecutorConfigs(c, options), c.Load, c.ExecuteSync)
+}
+
+// CreateAsyncExecutorWithLoading returns an ExecutorWithLoading encapsulating the
+// loading & executing tasks that would be handled by the given component, together
+// with the Future of its result.
+func CreateAsyncExecutorWithLoading[V any, T any](c AsyncComponentWithLoading[V, T], options ...ExecutorOption) (ExecutorWithLoading[V, T], Future[T]) {
+	return newAsyncExecutorWithLoading(newExecutorConfigs(c, options), c.Load, c.Execute)
+}
+
+// CreateSyncExecutorFromFunc works like CreateSyncExecutor for small steps that do
+// not deserve a component of their own. Executors created from functions are not
+// named by default, they should be named using WithName.
+func CreateSyncExecutorFromFunc[T any](executeFn func(ctx context.Context) (T, error), options ...ExecutorOption) (Executor[T], Future[T]) {
+	return newSyncExecutor(newExecutorConfigs(nil, options), executeFn)
+}
+
+// CreateAsyncExecutorFromFunc works like CreateAsyncExecutor for small steps that do
+// not deserve a component of their own. Executors created from functions are not
+// named by default, they should be named using WithName.
+func CreateAsyncExecutorFromFunc[T any](executeFn func(ctx context.Context) (T, error), options ...ExecutorOption) (Executor[T], Future[T]) {
+	return newAsyncExecutor(newExecutorConfigs(nil, options), executeFn)
+}
+
+// CreateSyncExecutorWithLoadingFromFunc works like CreateSyncExecutorWithLoading for
+// small steps that do not deserve a component of their own. Executors created from
+// functions are not named by default, they should be named using WithName.
+func CreateSyncExecutorWithLoadingFromFunc[V any, T any](
+	loadFn func(ctx context.Context) (V, error),
+	executeFn func(ctx context.Context, data LoadData[V]) (T, error),
+	options ...ExecutorOption,
+) (ExecutorWithLoading[V, T], Future[T]) {
+	return newSyncExecutorWithLoading(newExecutorConfigs(nil, options), loadFn, executeFn)
+}
+
+// CreateAsyncExecutorWithLoadingFromFunc works like CreateAsyncExecutorWithLoading for
+// small steps that do not deserve a component of their own. Executors created from
+// functions are not named by default, they should be named using WithName.
+func CreateAsyncExecutorWithLoadingFromFunc[V any, T any](
+	loadFn func(ctx context.Context) (V, error),
+	executeFn func(ctx context.Context, data LoadData[V]) (T, error),
+	options ...ExecutorOption,
+) (ExecutorWithLoading[V, T], Future[T]) {
+	return newAsyncExecutorWithLoading(newExecutorConfigs(nil, options), loadFn, executeFn)
+}
+
+// CreateSyncOrchestratingExecutor returns a component that is meant for orchestrating
+// some logic without returning any values beside throwing an error if necessary.
+func CreateSyncOrchestratingExecutor(doFn func(ctx context.Context) error, options ...ExecutorOption) Executor[any] {
+	e, _ := newSyncExecutor(
+		newExecutorConfigs(nil, options),
+		func(ctx context.Context) (any, error) {
+			return nil, doFn(ctx)
 		},
 	)
 
+	return e
+}
+
+// CreateSyncOrchestratingExecutorWithResult returns a component that is meant
+// for orchestrating some logic that returns some values and throws an error
+// if necessary, together with the Future of these values.
+func CreateSyncOrchestratingExecutorWithResult[T any](doFn func(ctx context.Context) (T, error), options ...ExecutorOption) (Executor[T], Future[T]) {
+	return newSyncExecutor(newExecutorConfigs(nil, options), doFn)
+}
+
+func newSyncExecutor[T any](configs *executorConfigs, executeFn func(ctx context.Context) (T, error)) (Executor[T], Future[T]) {
 	executingSyncTask := async.NewTask[T](
 		func(ctx context.Context) (T, error) {
-			// Block & wait
-			data, err := loadingTask.Outcome()
+			return intercept(ctx, PhaseExecuteSync, withTimeout(configs.executeTimeout, executeFn))
+		},
+	)
+
+	return Executor[T]{
+		executorMetadata:  executorMetadata{configs},
+		executingSyncTask: executingSyncTask,
+	}, newFuture(configs, executingSyncTask)
+}
 
-			return c.ExecuteSync(
+func newAsyncExecutor[T any](configs *executorConfigs, executeFn func(ctx context.Context) (T, error)) (Executor[T], Future[T]) {
+	executingAsyncTask := async.NewTask[T](
+		func(ctx context.Context) (T, error) {
+			return intercept(
 				ctx,
-				LoadData[V]{
-					Data: data,
-					Err:  err,
-				},
+				PhaseExecuteAsync,
+				withTimeout(
+					configs.executeTimeout, func(ctx context.Context) (T, error) {
+						return executeInSingleFlight(ctx, configs.singleFlightGroup, configs.singleFlightKey, executeFn)
+					},
+				),
 			)
 		},
 	)
 
+	return Executor[T]{
+		executorMetadata:   executorMetadata{configs},
+		executingAsyncTask: executingAsyncTask,
+	}, newFuture(configs, executingAsyncTask)
+}
+
+func newSyncExecutorWithLoading[V any, T any](
+	configs *executorConfigs,
+	loadFn func(ctx context.Context) (V, error),
+	executeFn func(ctx context.Context, data LoadData[V]) (T, error),
+) (ExecutorWithLoading[V, T], Future[T]) {
+	loadingTask := newLoadingTask(configs, loadFn)
+
+	executingSyncTask := async.NewTask[T](
+		func(ctx context.Context) (T, error) {
+			// Block & wait
+			data, err := Await(withPhase(ctx, PhaseExecuteSync), loadingTask)
+
+			return intercept(ctx, PhaseExecuteSync, withLoadData(configs, executeFn, data, err))
+		},
+	)
+
 	return ExecutorWithLoading[V, T]{
+		executorMetadata:  executorMetadata{configs},
 		loadingTask:       loadingTask,
 		executingSyncTask: executingSyncTask,
-	}
+	}, newFuture(configs, executingSyncTask)
 }
 
-// CreateSyncOrchestratingExecutor returns a component that is meant for orchestrating
-// some logic without returning any values beside throwing an error if necessary.
-func CreateSyncOrchestratingExecutor(doFn func(ctx context.Context) error) Executor[any] {
-	return Executor[any]{
-		executingSyncTask: async.NewTask[any](
-			func(ctx context.Context) (interface{}, error) {
-				return nil, doFn(ctx)
-			},
-		),
-	}
-}
+func newAsyncExecutorWithLoading[V any, T any](
+	configs *executorConfigs,
+	loadFn func(ctx context.Context) (V, error),
+	executeFn func(ctx context.Context, data LoadData[V]) (T, error),
+) (ExecutorWithLoading[V, T], Future[T]) {
+	loadingTask := newLoadingTask(configs, loadFn)
 
-// CreateSyncOrchestratingExecutorWithResult returns a component that is meant
-// for orchestrating some logic that returns some values and throws an error
-// if necessary.
-func CreateSyncOrchestratingExecutorWithResult[T any](doFn func(ctx context.Context) (T, error)) (Executor[T], async.Task[T]) {
-	t := async.NewTask[T](
+	executingAsyncTask := async.NewTask[T](
 		func(ctx context.Context) (T, error) {
-			return doFn(ctx)
+			// Load eagerly while waiting for upstream executors, flows
+			// start the loading task on their own, see loadingTasks
+			if _, ok := invocationScopeFrom(ctx); !ok {
+				loadingTask.Execute(ctx)
+			}
+
+			waitCtx := withPhase(ctx, PhaseExecuteAsync)
+			if err := awaitDependencies(waitCtx, configs.dependencies); err != nil {
+				var zero T
+				return zero, err
+			}
+
+			// Block & wait
+			data, err := Await(waitCtx, loadingTask)
+
+			return intercept(ctx, PhaseExecuteAsync, withLoadData(configs, executeFn, data, err))
 		},
 	)
 
-	return Executor[T]{
-		executingSyncTask: t,
-	}, t
+	return ExecutorWithLoading[V, T]{
+		executorMetadata:   executorMetadata{configs},
+		loadingTask:        loadingTask,
+		executingAsyncTask: executingAsyncTask,
+	}, newFuture(configs, executingAsyncTask)
+}
+
+func newLoadingTask[V any](configs *executorConfigs, loadFn func(ctx context.Context) (V, error)) async.Task[V] {
+	return async.NewTask[V](
+		func(ctx context.Context) (V, error) {
+			return intercept(
+				ctx,
+				PhaseLoad,
+				withTimeout(
+					configs.loadTimeout, func(ctx context.Context) (V, error) {
+						return executeInSingleFlight(ctx, configs.singleFlightGroup, configs.singleFlightKey, loadFn)
+					},
+				),
+			)
+		},
+	)
+}
+
+// withLoadData returns a function executing the main logic of a component
+// with loading using the given outcome of its loading task.
+func withLoadData[V any, T any](
+	configs *executorConfigs,
+	executeFn func(ctx context.Context, data LoadData[V]) (T, error),
+	data V,
+	err error,
+) func(ctx context.Context) (T, error) {
+	return withTimeout(
+		configs.executeTimeout, func(ctx context.Context) (T, error) {
+			return executeFn(
+				ctx,
+				LoadData[V]{
+					Data: data,
+					Err:  err,
+				},
+			)
+		},
+	)
 }
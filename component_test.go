@@ -3,6 +3,7 @@ package component
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -14,7 +15,7 @@ func TestCreateSyncExecutingTask(t *testing.T) {
 		Return(1, assert.AnError).
 		Once()
 
-	actual := CreateSyncExecutor[int](mockSyncComponent)
+	actual, _ := CreateSyncExecutor[int](mockSyncComponent)
 	assert.NotNil(t, actual.executingSyncTask)
 
 	err := actual.invokeSyncTask(context.Background())
@@ -31,7 +32,7 @@ func TestCreateAsyncExecutingTask(t *testing.T) {
 		Return(1, assert.AnError).
 		Once()
 
-	actual := CreateAsyncExecutor[int](mockAsyncComponent)
+	actual, _ := CreateAsyncExecutor[int](mockAsyncComponent)
 	assert.NotNil(t, actual.executingAsyncTask)
 
 	err := actual.invokeAsyncTask(context.Background())
@@ -51,7 +52,7 @@ func TestCreateSyncLoadingExecutingTask(t *testing.T) {
 		Return(2, assert.AnError).
 		Once()
 
-	actual := CreateSyncExecutorWithLoading[int, int](mockSyncComponentWithLoading)
+	actual, _ := CreateSyncExecutorWithLoading[int, int](mockSyncComponentWithLoading)
 	assert.NotNil(t, actual.loadingTask)
 	assert.NotNil(t, actual.executingSyncTask)
 
@@ -67,6 +68,111 @@ func TestCreateSyncLoadingExecutingTask(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, mockSyncComponentWithLoading)
 }
 
+func TestCreateAsyncLoadingExecutingTask(t *testing.T) {
+	mockAsyncComponentWithLoading := &MockAsyncComponentWithLoading[int, int]{}
+	mockAsyncComponentWithLoading.On("Load", mock.Anything).
+		Return(1, assert.AnError).
+		Once()
+	mockAsyncComponentWithLoading.On("Execute", mock.Anything, LoadData[int]{Data: 1, Err: assert.AnError}).
+		Return(2, assert.AnError).
+		Once()
+
+	actual, future := CreateAsyncExecutorWithLoading[int, int](mockAsyncComponentWithLoading)
+	assert.NotNil(t, actual.loadingTask)
+	assert.NotNil(t, actual.executingAsyncTask)
+	assert.Nil(t, actual.executingSyncTask)
+	assert.Equal(t, KindAsyncWithLoading, actual.kind())
+	assert.True(t, actual.canBeInvokedAsync())
+	assert.Nil(t, actual.invokeSyncTask(context.Background()))
+
+	// Loading task is started by the executing task
+	err := actual.invokeAsyncTask(context.Background())
+	assert.Equal(t, assert.AnError, err)
+
+	result, err := future.Get(context.Background())
+	assert.Equal(t, 2, result)
+	assert.ErrorIs(t, err, assert.AnError)
+
+	mock.AssertExpectationsForObjects(t, mockAsyncComponentWithLoading)
+}
+
+func TestCreateExecutorsWithTimeouts(t *testing.T) {
+	mockSyncComponentWithLoading := &MockSyncComponentWithLoading[int, int]{}
+	mockSyncComponentWithLoading.On("Load", mock.Anything).
+		Return(func(ctx context.Context) (int, error) {
+			deadline, ok := ctx.Deadline()
+			assert.True(t, ok)
+			assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+
+			return 1, nil
+		}).
+		Once()
+	mockSyncComponentWithLoading.On("ExecuteSync", mock.Anything, LoadData[int]{Data: 1}).
+		Return(func(ctx context.Context, data LoadData[int]) (int, error) {
+			deadline, ok := ctx.Deadline()
+			assert.True(t, ok)
+			assert.WithinDuration(t, time.Now().Add(time.Hour), deadline, time.Second)
+
+			return 2, nil
+		}).
+		Once()
+
+	e, future := CreateSyncExecutorWithLoading[int, int](mockSyncComponentWithLoading, WithLoadTimeout(time.Minute), WithExecuteTimeout(time.Hour))
+
+	assert.Nil(t, e.invokeAsyncTask(context.Background()))
+	assert.Nil(t, e.invokeSyncTask(context.Background()))
+	assert.Equal(t, 2, future.GetOrDefault(context.Background(), 0))
+
+	mock.AssertExpectationsForObjects(t, mockSyncComponentWithLoading)
+}
+
+func TestCreateExecutorsWithSingleFlight(t *testing.T) {
+	group := NewSingleFlightGroup()
+	release := make(chan struct{})
+
+	mockAsyncComponent := &MockAsyncComponent[int]{}
+	mockAsyncComponent.On("Execute", mock.Anything).
+		Run(func(args mock.Arguments) {
+			<-release
+		}).
+		Return(1, nil).
+		Once()
+
+	mockSyncComponentWithLoading := &MockSyncComponentWithLoading[int, int]{}
+	mockSyncComponentWithLoading.On("Load", mock.Anything).
+		Run(func(args mock.Arguments) {
+			<-release
+		}).
+		Return(2, nil).
+		Once()
+
+	e1, _ := CreateAsyncExecutor[int](mockAsyncComponent, WithSingleFlight(group, "async"))
+	e2, _ := CreateAsyncExecutor[int](mockAsyncComponent, WithSingleFlight(group, "async"))
+	e3, _ := CreateSyncExecutorWithLoading[int, int](mockSyncComponentWithLoading, WithSingleFlight(group, "loading"))
+	e4, _ := CreateSyncExecutorWithLoading[int, int](mockSyncComponentWithLoading, WithSingleFlight(group, "loading"))
+
+	e1.executingAsyncTask.Execute(context.Background())
+	e2.executingAsyncTask.Execute(context.Background())
+	e3.loadingTask.Execute(context.Background())
+	e4.loadingTask.Execute(context.Background())
+
+	assert.Eventually(t, func() bool {
+		group.mu.Lock()
+		defer group.mu.Unlock()
+
+		return len(group.flights) == 2 && group.flights["async"].waiters == 2 && group.flights["loading"].waiters == 2
+	}, time.Second, time.Millisecond)
+
+	close(release)
+
+	assert.Equal(t, 1, e1.executingAsyncTask.ResultOrDefault(0))
+	assert.Equal(t, 1, e2.executingAsyncTask.ResultOrDefault(0))
+	assert.Equal(t, 2, e3.loadingTask.ResultOrDefault(0))
+	assert.Equal(t, 2, e4.loadingTask.ResultOrDefault(0))
+
+	mock.AssertExpectationsForObjects(t, mockAsyncComponent, mockSyncComponentWithLoading)
+}
+
 func TestCreateOrchestratingTask(t *testing.T) {
 	doFn := func(ctx context.Context) error {
 		return assert.AnError
@@ -96,7 +202,7 @@ func TestCreateOrchestratingTaskWithResult(t *testing.T) {
 
 				err := pair.invokeSyncTask(context.Background())
 				assert.Equal(t, assert.AnError, err)
-				assert.Equal(t, 2, future.ResultOrDefault(2))
+				assert.Equal(t, 2, future.GetOrDefault(context.Background(), 2))
 			},
 		},
 		{
@@ -111,7 +217,7 @@ func TestCreateOrchestratingTaskWithResult(t *testing.T) {
 
 				err := pair.invokeSyncTask(context.Background())
 				assert.Nil(t, err)
-				assert.Equal(t, 1, future.ResultOrDefault(2))
+				assert.Equal(t, 1, future.GetOrDefault(context.Background(), 2))
 			},
 		},
 	}
@@ -121,3 +227,152 @@ func TestCreateOrchestratingTaskWithResult(t *testing.T) {
 		t.Run(sc.desc, sc.test)
 	}
 }
+
+func TestCreateExecutorsWithMetadata(t *testing.T) {
+	scenarios := []struct {
+		desc                  string
+		executor              IExecutor
+		expectedName          string
+		expectedComponentType string
+		expectedTags          map[string]string
+	}{
+		{
+			desc:                  "named after the component type by default",
+			executor:              executorOf(CreateAsyncExecutor[int](&MockAsyncComponent[int]{})),
+			expectedName:          "component.MockAsyncComponent[int]",
+			expectedComponentType: "component.MockAsyncComponent[int]",
+		},
+		{
+			desc:                  "component with loading",
+			executor:              executorOf(CreateSyncExecutorWithLoading[int, int](&MockSyncComponentWithLoading[int, int]{}, WithName("fare"))),
+			expectedName:          "fare",
+			expectedComponentType: "component.MockSyncComponentWithLoading[int,int]",
+		},
+		{
+			desc: "custom metadata",
+			executor: executorOf(
+				CreateSyncExecutor[int](
+					&MockSyncComponent[int]{},
+					WithComponentType("pricing"),
+					WithTags(map[string]string{"team": "pricing", "criticality": "low"}),
+					WithTags(map[string]string{"criticality": "high"}),
+				),
+			),
+			expectedName:          "pricing",
+			expectedComponentType: "pricing",
+			expectedTags:          map[string]string{"team": "pricing", "criticality": "high"},
+		},
+		{
+			desc:     "orchestrating executors are not named by default",
+			executor: CreateSyncOrchestratingExecutor(func(ctx context.Context) error { return nil }),
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(
+			sc.desc, func(t *testing.T) {
+				assert.Equal(t, sc.expectedName, sc.executor.Name())
+				assert.Equal(t, sc.expectedComponentType, sc.executor.ComponentType())
+				assert.Equal(t, sc.expectedTags, sc.executor.Tags())
+			},
+		)
+	}
+
+	e, _ := CreateAsyncExecutor[int](&MockAsyncComponent[int]{}, WithTags(map[string]string{"team": "pricing"}))
+	e.Tags()["team"] = "routing"
+	assert.Equal(t, "pricing", e.Tags()["team"], "tags cannot be modified via accessors")
+}
+
+// executorOf returns the given executor, dropping the future returned together with it.
+func executorOf[E IExecutor, T any](e E, _ Future[T]) E {
+	return e
+}
+
+func TestCreateExecutorsFromFunc(t *testing.T) {
+	scenarios := []struct {
+		desc         string
+		create       func() (IExecutor, Future[int])
+		expectedKind ExecutorKind
+	}{
+		{
+			desc: "sync",
+			create: func() (IExecutor, Future[int]) {
+				return CreateSyncExecutorFromFunc(
+					func(ctx context.Context) (int, error) {
+						return 1, nil
+					},
+					WithName("sync"),
+				)
+			},
+			expectedKind: KindSync,
+		},
+		{
+			desc: "async",
+			create: func() (IExecutor, Future[int]) {
+				return CreateAsyncExecutorFromFunc(
+					func(ctx context.Context) (int, error) {
+						return 1, nil
+					},
+					WithName("async"),
+				)
+			},
+			expectedKind: KindAsync,
+		},
+		{
+			desc: "sync with loading",
+			create: func() (IExecutor, Future[int]) {
+				return CreateSyncExecutorWithLoadingFromFunc(
+					func(ctx context.Context) (string, error) {
+						return "1", nil
+					},
+					func(ctx context.Context, data LoadData[string]) (int, error) {
+						return len(data.Data), data.Err
+					},
+					WithName("sync with loading"),
+				)
+			},
+			expectedKind: KindSyncWithLoading,
+		},
+		{
+			desc: "async with loading",
+			create: func() (IExecutor, Future[int]) {
+				return CreateAsyncExecutorWithLoadingFromFunc(
+					func(ctx context.Context) (string, error) {
+						return "1", nil
+					},
+					func(ctx context.Context, data LoadData[string]) (int, error) {
+						return len(data.Data), data.Err
+					},
+					WithName("async with loading"),
+				)
+			},
+			expectedKind: KindAsyncWithLoading,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(
+			sc.desc, func(t *testing.T) {
+				e, future := sc.create()
+				assert.Equal(t, sc.desc, e.Name())
+				assert.Empty(t, e.ComponentType())
+				assert.Equal(t, sc.expectedKind, e.kind())
+
+				report, err := ForkJoinFailingFastWithReport(
+					context.Background(),
+					NewExecutionFlowBuilder().
+						Append(e).
+						Get(),
+				)
+				assert.Nil(t, err)
+				assert.Equal(t, StatusSucceeded, report.Executors[0].Status)
+
+				actual, err := future.Get(context.Background())
+				assert.Equal(t, 1, actual)
+				assert.Nil(t, err)
+			},
+		)
+	}
+}
@@ -0,0 +1,329 @@
+// Package componenttest provides conformance tests that implementations of
+// component.CustomExecutor can run to verify that they behave like the
+// executors built into the component package.
+package componenttest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jamestrandung/go-component"
+	"github.com/stretchr/testify/assert"
+)
+
+// DefaultTimeout is how long the conformance tests wait for an executor to return by default.
+const DefaultTimeout = time.Second
+
+// CustomExecutorFactory creates the executors under test.
+type CustomExecutorFactory struct {
+	// NewSucceeding returns a new executor whose parts complete without errors.
+	NewSucceeding func() component.CustomExecutor
+	// NewBlocking returns a new executor whose parts block until they get cancelled
+	// or their context is done. Tests requiring blocking executors are skipped if nil.
+	NewBlocking func() component.CustomExecutor
+	// Timeout is how long to wait for an executor to return, DefaultTimeout if zero.
+	Timeout time.Duration
+}
+
+// RunCustomExecutorTests verifies that the executors created by the given factory satisfy
+// the contract of component.CustomExecutor. Each check runs as a subtest of t.
+func RunCustomExecutorTests(t *testing.T, factory CustomExecutorFactory) {
+	timeout := factory.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	cause := errors.New("cancelled by componenttest")
+
+	t.Run(
+		"succeeds on its own", func(t *testing.T) {
+			e := factory.NewSucceeding()
+
+			ok, err := withinTimeout(timeout, func() error { return executeParts(context.Background(), e) })
+			assert.True(t, ok, "executor did not return within %v", timeout)
+			assert.Nil(t, err)
+		},
+	)
+
+	t.Run(
+		"succeeds in a flow", func(t *testing.T) {
+			var syncLane []string
+
+			flow, err := component.NewExecutionFlowBuilder().
+				Append(
+					component.CreateSyncOrchestratingExecutor(
+						func(ctx context.Context) error {
+							syncLane = append(syncLane, "before")
+							return nil
+						},
+					),
+					component.CreateCustomExecutor(factory.NewSucceeding(), component.WithName("executor under test")),
+					component.CreateSyncOrchestratingExecutor(
+						func(ctx context.Context) error {
+							syncLane = append(syncLane, "after")
+							return nil
+						},
+					),
+				).
+				Build()
+			assert.Nil(t, err)
+
+			ok, err := withinTimeout(
+				timeout, func() error {
+					return component.ForkJoinFailingFast(context.Background(), flow)
+				},
+			)
+			assert.True(t, ok, "flow did not return within %v", timeout)
+			assert.Nil(t, err)
+			assert.Equal(t, []string{"before", "after"}, syncLane)
+		},
+	)
+
+	t.Run(
+		"fails the flow when panicking", func(t *testing.T) {
+			parts := []component.Phase{component.PhaseExecuteSync}
+			if factory.NewSucceeding().HasAsyncPart() {
+				parts = append(parts, component.PhaseExecuteAsync)
+			}
+
+			for _, part := range parts {
+				flow, err := component.NewExecutionFlowBuilder().
+					Append(
+						component.CreateCustomExecutor(
+							panickingExecutor{
+								CustomExecutor: factory.NewSucceeding(),
+								part:           part,
+							},
+							component.WithName("executor under test"),
+						),
+					).
+					Build()
+				assert.Nil(t, err)
+
+				ok, err := withinTimeout(
+					timeout, func() error {
+						return component.ForkJoinFailingFast(context.Background(), flow)
+					},
+				)
+				assert.True(t, ok, "flow did not return within %v", timeout)
+
+				var executorErr *component.ExecutorError
+				if assert.ErrorAs(t, err, &executorErr, "panic in %s", part) {
+					assert.Equal(t, "executor under test", executorErr.Executor)
+				}
+			}
+		},
+	)
+
+	t.Run(
+		"does not execute once cancelled", func(t *testing.T) {
+			e := factory.NewSucceeding()
+			e.Cancel(cause)
+
+			if e.HasAsyncPart() {
+				ok, err := withinTimeout(timeout, func() error { return e.ExecuteAsync(context.Background()) })
+				assert.True(t, ok, "ExecuteAsync did not return within %v", timeout)
+				assert.ErrorIs(t, err, component.ErrCancelled)
+			}
+
+			ok, err := withinTimeout(timeout, func() error { return e.ExecuteSync(context.Background()) })
+			assert.True(t, ok, "ExecuteSync did not return within %v", timeout)
+			assert.ErrorIs(t, err, component.ErrCancelled)
+		},
+	)
+
+	t.Run(
+		"can be cancelled more than once concurrently", func(t *testing.T) {
+			e := factory.NewSucceeding()
+
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					e.Cancel(cause)
+				}()
+			}
+
+			ok, _ := withinTimeout(
+				timeout, func() error {
+					wg.Wait()
+					return nil
+				},
+			)
+			assert.True(t, ok, "Cancel did not return within %v", timeout)
+		},
+	)
+
+	t.Run(
+		"returns promptly when cancelled", func(t *testing.T) {
+			if factory.NewBlocking == nil {
+				t.Skip("NewBlocking is not provided")
+			}
+
+			e := factory.NewBlocking()
+
+			errChan := make(chan error, 2)
+			parts := 1
+
+			if e.HasAsyncPart() {
+				parts++
+				go func() { errChan <- e.ExecuteAsync(context.Background()) }()
+			}
+
+			go func() { errChan <- e.ExecuteSync(context.Background()) }()
+
+			e.Cancel(cause)
+
+			for i := 0; i < parts; i++ {
+				select {
+				case err := <-errChan:
+					assert.ErrorIs(t, err, component.ErrCancelled)
+				case <-time.After(timeout):
+					assert.Fail(t, "executor did not return after being cancelled", "waited for %v", timeout)
+					return
+				}
+			}
+		},
+	)
+
+	t.Run(
+		"returns promptly when its context is done", func(t *testing.T) {
+			if factory.NewBlocking == nil {
+				t.Skip("NewBlocking is not provided")
+			}
+
+			e := factory.NewBlocking()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			ok, err := withinTimeout(timeout, func() error { return executeParts(ctx, e) })
+			assert.True(t, ok, "executor did not return within %v", timeout)
+			assert.NotNil(t, err)
+		},
+	)
+
+	t.Run(
+		"is cancelled when another executor fails", func(t *testing.T) {
+			if factory.NewBlocking == nil {
+				t.Skip("NewBlocking is not provided")
+			}
+
+			failingErr := errors.New("error from componenttest")
+
+			e := &observedExecutor{
+				CustomExecutor: factory.NewBlocking(),
+				errChan:        make(chan error, 2),
+			}
+
+			failing, _ := component.CreateAsyncExecutor[int](
+				failingComponent{err: failingErr},
+				component.WithName("failing"),
+			)
+
+			flow, err := component.NewExecutionFlowBuilder().
+				Append(
+					component.CreateCustomExecutor(e, component.WithName("executor under test")),
+					failing,
+				).
+				Build()
+			assert.Nil(t, err)
+
+			ok, err := withinTimeout(
+				timeout, func() error {
+					return component.ForkJoinFailingFast(context.Background(), flow)
+				},
+			)
+			assert.True(t, ok, "flow did not return within %v", timeout)
+			assert.Equal(t, &component.ExecutorError{Executor: "failing", Err: failingErr}, err)
+
+			select {
+			case err := <-e.errChan:
+				assert.ErrorIs(t, err, component.ErrCancelled)
+			case <-time.After(timeout):
+				assert.Fail(t, "executor did not return after being cancelled", "waited for %v", timeout)
+			}
+		},
+	)
+}
+
+// executeParts carries out the parts of the given executor like a flow would do.
+func executeParts(ctx context.Context, e component.CustomExecutor) error {
+	if e.HasAsyncPart() {
+		if err := e.ExecuteAsync(ctx); err != nil {
+			return err
+		}
+	}
+
+	return e.ExecuteSync(ctx)
+}
+
+// withinTimeout returns whether fn returned within the given timeout & the error it returned.
+func withinTimeout(timeout time.Duration, fn func() error) (bool, error) {
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- fn()
+	}()
+
+	select {
+	case err := <-errChan:
+		return true, err
+	case <-time.After(timeout):
+		return false, nil
+	}
+}
+
+// observedExecutor reports the errors returned by the parts of the executor it wraps.
+type observedExecutor struct {
+	component.CustomExecutor
+	errChan chan error
+}
+
+func (e *observedExecutor) ExecuteAsync(ctx context.Context) error {
+	err := e.CustomExecutor.ExecuteAsync(ctx)
+	e.errChan <- err
+
+	return err
+}
+
+func (e *observedExecutor) ExecuteSync(ctx context.Context) error {
+	err := e.CustomExecutor.ExecuteSync(ctx)
+	e.errChan <- err
+
+	return err
+}
+
+// panickingExecutor panics in the given part of the executor it wraps.
+type panickingExecutor struct {
+	component.CustomExecutor
+	part component.Phase
+}
+
+func (e panickingExecutor) ExecuteAsync(ctx context.Context) error {
+	if e.part == component.PhaseExecuteAsync {
+		panic("panic from componenttest")
+	}
+
+	return e.CustomExecutor.ExecuteAsync(ctx)
+}
+
+func (e panickingExecutor) ExecuteSync(ctx context.Context) error {
+	if e.part == component.PhaseExecuteSync {
+		panic("panic from componenttest")
+	}
+
+	return e.CustomExecutor.ExecuteSync(ctx)
+}
+
+type failingComponent struct {
+	err error
+}
+
+func (c failingComponent) Execute(ctx context.Context) (int, error) {
+	<-time.After(10 * time.Millisecond)
+	return 0, c.err
+}
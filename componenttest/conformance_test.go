@@ -0,0 +1,102 @@
+package componenttest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/jamestrandung/go-component"
+)
+
+func TestRunCustomExecutorTests(t *testing.T) {
+	scenarios := []struct {
+		desc         string
+		hasAsyncPart bool
+	}{
+		{
+			desc:         "executor with async part",
+			hasAsyncPart: true,
+		},
+		{
+			desc:         "executor with sync part only",
+			hasAsyncPart: false,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(
+			sc.desc, func(t *testing.T) {
+				RunCustomExecutorTests(
+					t,
+					CustomExecutorFactory{
+						NewSucceeding: func() component.CustomExecutor {
+							return newReferenceExecutor(sc.hasAsyncPart, false)
+						},
+						NewBlocking: func() component.CustomExecutor {
+							return newReferenceExecutor(sc.hasAsyncPart, true)
+						},
+					},
+				)
+			},
+		)
+	}
+}
+
+// referenceExecutor is a minimal CustomExecutor satisfying its contract.
+type referenceExecutor struct {
+	hasAsyncPart bool
+	blocking     bool
+	cancelOnce   sync.Once
+	cancelled    chan struct{}
+	cause        error
+}
+
+func newReferenceExecutor(hasAsyncPart bool, blocking bool) *referenceExecutor {
+	return &referenceExecutor{
+		hasAsyncPart: hasAsyncPart,
+		blocking:     blocking,
+		cancelled:    make(chan struct{}),
+	}
+}
+
+func (e *referenceExecutor) HasAsyncPart() bool {
+	return e.hasAsyncPart
+}
+
+func (e *referenceExecutor) ExecuteAsync(ctx context.Context) error {
+	return e.execute(ctx)
+}
+
+func (e *referenceExecutor) ExecuteSync(ctx context.Context) error {
+	return e.execute(ctx)
+}
+
+func (e *referenceExecutor) execute(ctx context.Context) error {
+	select {
+	case <-e.cancelled:
+		return fmt.Errorf("%w: %v", component.ErrCancelled, e.cause)
+	default:
+	}
+
+	if !e.blocking {
+		return nil
+	}
+
+	select {
+	case <-e.cancelled:
+		return fmt.Errorf("%w: %v", component.ErrCancelled, e.cause)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (e *referenceExecutor) Cancel(cause error) {
+	e.cancelOnce.Do(
+		func() {
+			e.cause = cause
+			close(e.cancelled)
+		},
+	)
+}
@@ -0,0 +1,199 @@
+package component
+
+import (
+	"time"
+)
+
+// CriticalPathStep is the part of an executor phase lying on the critical path of a flow execution.
+type CriticalPathStep struct {
+	Name        string
+	LayerIdx    int
+	ExecutorIdx int
+	Phase       Phase
+	StartedAt   time.Time
+	EndedAt     time.Time
+}
+
+// Contribution returns how much this step contributed to the end-to-end latency of the flow.
+func (s CriticalPathStep) Contribution() time.Duration {
+	return s.EndedAt.Sub(s.StartedAt)
+}
+
+// CriticalPath returns the chain of executor phases that determined the end-to-end latency of the
+// flow execution, in the order in which they ran. Shortening any other phase would not have made
+// the flow return earlier.
+//
+// The path is built backward from the phase that completed last. A phase that got blocked waiting
+// for another phase, either via Await or because it's waiting for its loading task, hands over the
+// critical path to the phase it was waiting for. A phase in the sync lane that could not start until
+// the previous one completed hands over the critical path to this previous phase. Phases that were
+// still running when the flow returned are not taken into account.
+func (r ExecutionReport) CriticalPath() []CriticalPathStep {
+	a := newCriticalPathAnalysis(r)
+
+	current, ok := a.lastCompletedPhase()
+	if !ok {
+		return nil
+	}
+
+	var steps []CriticalPathStep
+
+	until := a.phases[current].EndedAt
+	visited := make(map[phaseKey]bool)
+
+	for ok && !visited[current] {
+		visited[current] = true
+
+		p := a.phases[current]
+
+		w, hasWait := a.latestWait(current, until)
+
+		// Blocked in the middle of this phase, only the work
+		// after the wait is on the critical path
+		if hasWait && w.EndedAt.After(p.StartedAt) {
+			steps = append(steps, a.step(current, w.EndedAt, until))
+			current, until = a.handOver(w)
+
+			continue
+		}
+
+		steps = append(steps, a.step(current, p.StartedAt, until))
+
+		// This phase could only start after the latest of its
+		// loading task & the previous phase in the sync lane
+		previous, hasPrevious := a.previousInSyncLane(current)
+
+		switch {
+		case hasWait && (!hasPrevious || w.EndedAt.After(a.phases[previous].EndedAt)):
+			current, until = a.handOver(w)
+		case hasPrevious:
+			current, until = previous, earliest(p.StartedAt, a.phases[previous].EndedAt)
+		default:
+			ok = false
+		}
+	}
+
+	// Steps were collected backward
+	for i, j := 0, len(steps)-1; i < j; i, j = i+1, j-1 {
+		steps[i], steps[j] = steps[j], steps[i]
+	}
+
+	return steps
+}
+
+type criticalPathAnalysis struct {
+	names  map[[2]int]string
+	phases map[phaseKey]PhaseReport
+	waits  map[phaseKey][]WaitReport
+}
+
+func newCriticalPathAnalysis(r ExecutionReport) criticalPathAnalysis {
+	a := criticalPathAnalysis{
+		names:  make(map[[2]int]string),
+		phases: make(map[phaseKey]PhaseReport),
+		waits:  make(map[phaseKey][]WaitReport),
+	}
+
+	for _, e := range r.Executors {
+		a.names[[2]int{e.LayerIdx, e.ExecutorIdx}] = e.Name
+
+		for _, p := range e.phases() {
+			if p.StartedAt.IsZero() || p.EndedAt.IsZero() {
+				continue
+			}
+
+			a.phases[phaseKey{e.LayerIdx, e.ExecutorIdx, p.Phase}] = p
+		}
+	}
+
+	for _, e := range r.Executors {
+		for _, w := range e.Waits {
+			consumer := phaseKey{e.LayerIdx, e.ExecutorIdx, w.Phase}
+			// Waiting for a phase that did not complete cannot be on the critical path
+			if _, ok := a.phases[producerOf(w)]; !ok {
+				continue
+			}
+
+			a.waits[consumer] = append(a.waits[consumer], w)
+		}
+	}
+
+	return a
+}
+
+func (a criticalPathAnalysis) lastCompletedPhase() (phaseKey, bool) {
+	var result phaseKey
+	found := false
+
+	for key, p := range a.phases {
+		if !found || p.EndedAt.After(a.phases[result].EndedAt) {
+			result, found = key, true
+		}
+	}
+
+	return result, found
+}
+
+// latestWait returns the wait of the given phase that ended last, no later than until.
+func (a criticalPathAnalysis) latestWait(key phaseKey, until time.Time) (WaitReport, bool) {
+	var result WaitReport
+	found := false
+
+	for _, w := range a.waits[key] {
+		if w.EndedAt.After(until) {
+			continue
+		}
+
+		if !found || w.EndedAt.After(result.EndedAt) {
+			result, found = w, true
+		}
+	}
+
+	return result, found
+}
+
+// previousInSyncLane returns the sync phase executed right before the given one in its layer.
+func (a criticalPathAnalysis) previousInSyncLane(key phaseKey) (phaseKey, bool) {
+	if key.phase != PhaseExecuteSync {
+		return phaseKey{}, false
+	}
+
+	for idx := key.executorIdx - 1; idx >= 0; idx-- {
+		previous := phaseKey{key.layerIdx, idx, PhaseExecuteSync}
+		if _, ok := a.phases[previous]; ok {
+			return previous, true
+		}
+	}
+
+	return phaseKey{}, false
+}
+
+// handOver returns the phase the given wait was for and the time until which this
+// phase is on the critical path.
+func (a criticalPathAnalysis) handOver(w WaitReport) (phaseKey, time.Time) {
+	producer := producerOf(w)
+	return producer, earliest(w.EndedAt, a.phases[producer].EndedAt)
+}
+
+func producerOf(w WaitReport) phaseKey {
+	return phaseKey{w.ProducerLayerIdx, w.ProducerExecutorIdx, w.ProducerPhase}
+}
+
+func (a criticalPathAnalysis) step(key phaseKey, startedAt time.Time, endedAt time.Time) CriticalPathStep {
+	return CriticalPathStep{
+		Name:        a.names[[2]int{key.layerIdx, key.executorIdx}],
+		LayerIdx:    key.layerIdx,
+		ExecutorIdx: key.executorIdx,
+		Phase:       key.phase,
+		StartedAt:   startedAt,
+		EndedAt:     endedAt,
+	}
+}
+
+func earliest(t1 time.Time, t2 time.Time) time.Time {
+	if t1.Before(t2) {
+		return t1
+	}
+
+	return t2
+}
@@ -0,0 +1,167 @@
+package component
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExecutionReport_CriticalPath(t *testing.T) {
+	sleepingAsyncExecutor := func(name string, d time.Duration) Executor[int] {
+		return executorOf(
+			CreateAsyncExecutor[int](
+				asyncComponentFunc[int](
+					func(ctx context.Context) (int, error) {
+						<-time.After(d)
+						return 1, nil
+					},
+				),
+				WithName(name),
+			),
+		)
+	}
+
+	sleepingSyncExecutor := func(name string, d time.Duration) Executor[any] {
+		return CreateSyncOrchestratingExecutor(
+			func(ctx context.Context) error {
+				<-time.After(d)
+				return nil
+			},
+			WithName(name),
+		)
+	}
+
+	fareExecutor := func(loadDuration time.Duration, routing Executor[int]) ExecutorWithLoading[int, int] {
+		mockSyncComponentWithLoading := &MockSyncComponentWithLoading[int, int]{}
+		mockSyncComponentWithLoading.On("Load", mock.Anything).
+			Run(func(args mock.Arguments) {
+				<-time.After(loadDuration)
+			}).
+			Return(1, nil).
+			Once()
+		mockSyncComponentWithLoading.On("ExecuteSync", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				_, _ = Await(args.Get(0).(context.Context), routing.GetExecutingTask())
+			}).
+			Return(1, nil).
+			Once()
+
+		return executorOf(CreateSyncExecutorWithLoading[int, int](mockSyncComponentWithLoading, WithName("fare")))
+	}
+
+	type expectedStep struct {
+		name  string
+		phase Phase
+	}
+
+	scenarios := []struct {
+		desc     string
+		flow     func() ExecutionFlow
+		expected []expectedStep
+	}{
+		{
+			desc: "empty flow",
+			flow: func() ExecutionFlow {
+				return ExecutionFlow{}
+			},
+		},
+		{
+			desc: "waiting for a slow upstream",
+			flow: func() ExecutionFlow {
+				routing := sleepingAsyncExecutor("routing", 50*time.Millisecond)
+
+				return NewExecutionFlowBuilder().
+					Append(
+						routing,
+						fareExecutor(time.Millisecond, routing),
+						sleepingSyncExecutor("rounding", time.Millisecond),
+					).
+					Get()
+			},
+			expected: []expectedStep{
+				{"routing", PhaseExecuteAsync},
+				{"fare", PhaseExecuteSync},
+				{"rounding", PhaseExecuteSync},
+			},
+		},
+		{
+			desc: "waiting for a slow loading task",
+			flow: func() ExecutionFlow {
+				routing := sleepingAsyncExecutor("routing", time.Millisecond)
+
+				return NewExecutionFlowBuilder().
+					Append(
+						routing,
+						fareExecutor(50*time.Millisecond, routing),
+						sleepingSyncExecutor("rounding", time.Millisecond),
+					).
+					Get()
+			},
+			expected: []expectedStep{
+				{"fare", PhaseLoad},
+				{"fare", PhaseExecuteSync},
+				{"rounding", PhaseExecuteSync},
+			},
+		},
+		{
+			desc: "slow sync lane",
+			flow: func() ExecutionFlow {
+				return NewExecutionFlowBuilder().
+					Append(
+						sleepingAsyncExecutor("routing", time.Millisecond),
+						sleepingSyncExecutor("validation", 50*time.Millisecond),
+						sleepingSyncExecutor("rounding", time.Millisecond),
+					).
+					Get()
+			},
+			expected: []expectedStep{
+				{"validation", PhaseExecuteSync},
+				{"rounding", PhaseExecuteSync},
+			},
+		},
+		{
+			desc: "slow layer",
+			flow: func() ExecutionFlow {
+				return NewExecutionFlowBuilder().
+					Append(sleepingSyncExecutor("validation", time.Millisecond)).
+					NextLayer().
+					Append(sleepingAsyncExecutor("routing", 50*time.Millisecond)).
+					Get()
+			},
+			expected: []expectedStep{
+				{"routing", PhaseExecuteAsync},
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(
+			sc.desc, func(t *testing.T) {
+				report, err := ForkJoinFailingFastWithReport(context.Background(), sc.flow())
+				assert.Nil(t, err)
+
+				path := report.CriticalPath()
+
+				var actual []expectedStep
+				var total time.Duration
+				for _, step := range path {
+					actual = append(actual, expectedStep{step.Name, step.Phase})
+					total += step.Contribution()
+
+					assert.True(t, step.Contribution() >= 0)
+				}
+
+				assert.Equal(t, sc.expected, actual)
+				assert.True(t, total <= report.Duration())
+
+				if len(path) > 0 {
+					assert.True(t, total >= 50*time.Millisecond, "the slowest phase must be on the critical path")
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,118 @@
+package component
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+)
+
+// CustomExecutor lets engineers plug new kinds of executors, e.g. executors backed by a remote
+// worker or a cache, into an ExecutionFlow via CreateCustomExecutor. Like the executors of built-in
+// components, a CustomExecutor may have an async part that runs concurrently with the other
+// executors of its layer as soon as the flow starts & a sync part that runs in the sync lane of
+// its layer, after the sync parts of the executors appended before it.
+//
+// Implementations can verify that they satisfy this contract using the componenttest package.
+type CustomExecutor interface {
+	// HasAsyncPart returns whether ExecuteAsync should be invoked.
+	HasAsyncPart() bool
+	// ExecuteAsync carries out the async part of this executor in its own goroutine. Returning
+	// an error not wrapping ErrCancelled stops the entire flow with this error.
+	ExecuteAsync(ctx context.Context) error
+	// ExecuteSync carries out the sync part of this executor in the sync lane. Returning an
+	// error not wrapping ErrCancelled stops the entire flow with this error.
+	ExecuteSync(ctx context.Context) error
+	// Cancel cancels this executor for the given cause, which happens when another executor
+	// in the same or a previous layer fails. The cause wraps ErrCancelled. Both parts must
+	// then return an error wrapping ErrCancelled, e.g. the cause, as soon as possible,
+	// including those that have not started yet. Cancel may be called more than once &
+	// concurrently with the other methods.
+	Cancel(cause error)
+}
+
+// CreateCustomExecutor returns an executor carrying out the given CustomExecutor in an ExecutionFlow.
+// Panics in the CustomExecutor & the interceptors around it are turned into errors, like the ones in
+// built-in components. ExecutionFlowBuilder.Build reports ErrNilExecutor if the CustomExecutor is nil.
+func CreateCustomExecutor(e CustomExecutor, options ...ExecutorOption) IExecutor {
+	return &customExecutor{
+		executorMetadata: executorMetadata{newExecutorConfigs(e, options)},
+		custom:           e,
+	}
+}
+
+type customExecutor struct {
+	executorMetadata
+	custom CustomExecutor
+}
+
+func (e *customExecutor) invokeSyncTask(ctx context.Context) error {
+	return e.invoke(ctx, PhaseExecuteSync, e.custom.ExecuteSync)
+}
+
+func (e *customExecutor) canBeInvokedAsync() bool {
+	return e.custom != nil && e.custom.HasAsyncPart()
+}
+
+func (e *customExecutor) invokeAsyncTask(ctx context.Context) error {
+	return e.invoke(ctx, PhaseExecuteAsync, e.custom.ExecuteAsync)
+}
+
+// invoke carries out the given part of the CustomExecutor via the interceptors of its flow.
+func (e *customExecutor) invoke(ctx context.Context, phase Phase, fn func(ctx context.Context) error) (err error) {
+	defer recoverCustom(&err)
+
+	_, err = intercept(
+		ctx,
+		phase,
+		func(ctx context.Context) (any, error) {
+			return nil, fn(ctx)
+		},
+	)
+
+	return err
+}
+
+// recoverCustom turns a panic in a CustomExecutor into an error assigned to err.
+func recoverCustom(err *error) {
+	if r := recover(); r != nil {
+		*err = fmt.Errorf("panic executing custom executor: %v \n %s", r, debug.Stack())
+	}
+}
+
+func (e *customExecutor) cancel(err error) {
+	e.custom.Cancel(err)
+}
+
+func (e *customExecutor) kind() ExecutorKind {
+	return KindCustom
+}
+
+func (e *customExecutor) phaseTasks() map[Phase]any {
+	if e.custom == nil {
+		return nil
+	}
+
+	// This executor stands for its own tasks
+	if e.custom.HasAsyncPart() {
+		return map[Phase]any{
+			PhaseExecuteAsync: e,
+		}
+	}
+
+	return map[Phase]any{
+		PhaseExecuteSync: e,
+	}
+}
+
+// InvokeExecutingTask carries out both parts of this executor one after another.
+func (e *customExecutor) InvokeExecutingTask(ctx context.Context) (err error) {
+	defer recoverCustom(&err)
+
+	if e.custom.HasAsyncPart() {
+		if err := e.custom.ExecuteAsync(ctx); err != nil {
+			return err
+		}
+	}
+
+	return e.custom.ExecuteSync(ctx)
+}
@@ -0,0 +1,147 @@
+package component
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/jamestrandung/go-concurrency/v2/async"
+	"github.com/stretchr/testify/assert"
+)
+
+// customExecutorFuncs is a CustomExecutor made of functions.
+type customExecutorFuncs struct {
+	executeAsync func(ctx context.Context) error
+	executeSync  func(ctx context.Context) error
+	cancelled    chan error
+}
+
+func (e customExecutorFuncs) HasAsyncPart() bool {
+	return e.executeAsync != nil
+}
+
+func (e customExecutorFuncs) ExecuteAsync(ctx context.Context) error {
+	return e.executeAsync(ctx)
+}
+
+func (e customExecutorFuncs) ExecuteSync(ctx context.Context) error {
+	return e.executeSync(ctx)
+}
+
+func (e customExecutorFuncs) Cancel(cause error) {
+	select {
+	case e.cancelled <- cause:
+	default:
+	}
+}
+
+func TestCreateCustomExecutor(t *testing.T) {
+	scenarios := []struct {
+		desc string
+		test func(t *testing.T)
+	}{
+		{
+			desc: "parts are executed & reported",
+			test: func(t *testing.T) {
+				parts := make(chan string, 2)
+				asyncDone := make(chan struct{})
+
+				e := CreateCustomExecutor(
+					customExecutorFuncs{
+						executeAsync: func(ctx context.Context) error {
+							parts <- "async"
+							close(asyncDone)
+							return nil
+						},
+						executeSync: func(ctx context.Context) error {
+							<-asyncDone
+							parts <- "sync"
+							return nil
+						},
+					},
+					WithTags(map[string]string{"backend": "remote"}),
+				)
+
+				assert.Equal(t, "component.customExecutorFuncs", e.Name())
+				assert.Equal(t, map[string]string{"backend": "remote"}, e.Tags())
+
+				report, err := ForkJoinFailingFastWithReport(context.Background(), NewExecutionFlowBuilder().Append(e).Get())
+				assert.Nil(t, err)
+				assert.Equal(t, "async", <-parts)
+				assert.Equal(t, "sync", <-parts)
+
+				er := report.Executors[0]
+				assert.Equal(t, KindCustom, er.Kind)
+				assert.Equal(t, StatusSucceeded, er.Status)
+				assert.Equal(t, PhaseExecuteAsync, er.Async.Phase)
+				assert.Equal(t, StatusSucceeded, er.Async.Status)
+				assert.Equal(t, StatusNotStarted, er.Load.Status)
+				assert.Equal(t, PhaseExecuteSync, er.Execute.Phase)
+			},
+		},
+		{
+			desc: "errors fail the flow & cancellations are swallowed",
+			test: func(t *testing.T) {
+				cancelled := make(chan error, 1)
+
+				blocking := CreateCustomExecutor(
+					customExecutorFuncs{
+						executeAsync: func(ctx context.Context) error {
+							return fmt.Errorf("%w: %v", ErrCancelled, <-cancelled)
+						},
+						executeSync: func(ctx context.Context) error {
+							return nil
+						},
+						cancelled: cancelled,
+					},
+				)
+
+				failing := CreateCustomExecutor(
+					customExecutorFuncs{
+						executeSync: func(ctx context.Context) error {
+							return assert.AnError
+						},
+					},
+				)
+
+				report, err := ForkJoinFailingFastWithReport(context.Background(), NewExecutionFlowBuilder().Append(blocking, failing).Get())
+				assert.Equal(t, &ExecutorError{Executor: "component.customExecutorFuncs", Err: assert.AnError}, err)
+				assert.Equal(t, StatusFailed, report.Executors[1].Status)
+			},
+		},
+		{
+			desc: "the same custom executor cannot be appended twice",
+			test: func(t *testing.T) {
+				e := CreateCustomExecutor(customExecutorFuncs{}, WithName("custom"))
+
+				_, err := NewExecutionFlowBuilder().Append(e, e).Build()
+				assert.ErrorIs(t, err, ErrDuplicateExecutor)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(sc.desc, sc.test)
+	}
+}
+
+func TestIsCancellation(t *testing.T) {
+	assert.True(t, isCancellation(fmt.Errorf("%w: some cause", ErrCancelled)))
+	assert.True(t, isCancellation(cancelReason(assert.AnError)))
+	assert.False(t, isCancellation(assert.AnError))
+	assert.False(t, isCancellation(fmt.Errorf("task cancelled with reason: %v", assert.AnError)))
+
+	task := async.NewTask(
+		func(ctx context.Context) (int, error) {
+			return 1, nil
+		},
+	)
+
+	task.CancelWithReason(cancelReason(assert.AnError))
+
+	_, err := outcomeOf(task)
+	assert.True(t, isCancellation(err))
+	assert.ErrorContains(t, err, assert.AnError.Error())
+	assert.Equal(t, err, errorOf(task))
+}
@@ -0,0 +1,194 @@
+package component
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ToDOT renders the structure of this flow in the Graphviz DOT language. Each layer is a
+// cluster in which sync executors are chained by edges in the order they get executed
+// while async executors stand on their own since they run in parallel. Dependencies
+// declared via DependsOn are rendered as dashed edges.
+func (f ExecutionFlow) ToDOT() string {
+	d := newFlowDiagram(f)
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "digraph %s {\n", dotQuote(labelOrUnnamed(f.name)))
+	sb.WriteString("\trankdir=LR;\n")
+	sb.WriteString("\tnode [fontname=\"Helvetica\"];\n")
+
+	for layerIdx, layer := range d.layers {
+		fmt.Fprintf(&sb, "\n\tsubgraph cluster_layer_%d {\n", layerIdx)
+		fmt.Fprintf(&sb, "\t\tlabel=%s;\n", dotQuote(fmt.Sprintf("layer %d", layerIdx)))
+
+		for _, n := range layer {
+			shape := "box"
+			if n.kind.isAsync() {
+				shape = "ellipse"
+			}
+
+			fmt.Fprintf(&sb, "\t\t%s [label=%s, shape=%s];\n", n.id, dotQuote(n.label+"\n"+string(n.kind)), shape)
+		}
+
+		for _, e := range d.syncLaneEdges(layerIdx) {
+			fmt.Fprintf(&sb, "\t\t%s -> %s;\n", e[0], e[1])
+		}
+
+		sb.WriteString("\t}\n")
+	}
+
+	if dependencyEdges := d.dependencyEdges(); len(dependencyEdges) > 0 {
+		sb.WriteString("\n")
+
+		for _, e := range dependencyEdges {
+			fmt.Fprintf(&sb, "\t%s -> %s [style=dashed];\n", e[0], e[1])
+		}
+	}
+
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+// ToMermaid renders the structure of this flow as a Mermaid flowchart. Each layer is a
+// subgraph in which sync executors are chained by edges in the order they get executed
+// while async executors stand on their own since they run in parallel. Dependencies
+// declared via DependsOn are rendered as dotted edges.
+func (f ExecutionFlow) ToMermaid() string {
+	d := newFlowDiagram(f)
+
+	var sb strings.Builder
+
+	sb.WriteString("flowchart LR\n")
+
+	for layerIdx, layer := range d.layers {
+		fmt.Fprintf(&sb, "\tsubgraph layer_%d [%s]\n", layerIdx, mermaidQuote(fmt.Sprintf("layer %d", layerIdx)))
+
+		for _, n := range layer {
+			label := mermaidQuote(n.label + "<br/>" + string(n.kind))
+
+			if n.kind.isAsync() {
+				// Stadium-shaped node
+				fmt.Fprintf(&sb, "\t\t%s([%s])\n", n.id, label)
+				continue
+			}
+
+			fmt.Fprintf(&sb, "\t\t%s[%s]\n", n.id, label)
+		}
+
+		for _, e := range d.syncLaneEdges(layerIdx) {
+			fmt.Fprintf(&sb, "\t\t%s --> %s\n", e[0], e[1])
+		}
+
+		sb.WriteString("\tend\n")
+	}
+
+	for _, e := range d.dependencyEdges() {
+		fmt.Fprintf(&sb, "\t%s -.-> %s\n", e[0], e[1])
+	}
+
+	return sb.String()
+}
+
+type flowDiagramNode struct {
+	id       string
+	label    string
+	kind     ExecutorKind
+	executor IExecutor
+}
+
+// flowDiagram holds the nodes of a flow to be rendered, independently of the output format.
+type flowDiagram struct {
+	layers [][]flowDiagramNode
+	// ids maps the tasks of each executor to the id of its node
+	ids map[any]string
+}
+
+func newFlowDiagram(f ExecutionFlow) flowDiagram {
+	d := flowDiagram{
+		layers: make([][]flowDiagramNode, len(f.Executors)),
+		ids:    make(map[any]string),
+	}
+
+	for layerIdx, executors := range f.Executors {
+		for executorIdx, e := range executors {
+			n := flowDiagramNode{
+				id:       fmt.Sprintf("executor_%d_%d", layerIdx, executorIdx),
+				label:    executorLabel(e.Name(), layerIdx, executorIdx),
+				kind:     e.kind(),
+				executor: e,
+			}
+
+			d.layers[layerIdx] = append(d.layers[layerIdx], n)
+
+			for _, t := range e.phaseTasks() {
+				if t != nil {
+					d.ids[t] = n.id
+				}
+			}
+		}
+	}
+
+	return d
+}
+
+// syncLaneEdges returns the edges chaining the sync executors in the given layer in their order of execution.
+func (d flowDiagram) syncLaneEdges(layerIdx int) [][2]string {
+	var result [][2]string
+
+	previous := ""
+	for _, n := range d.layers[layerIdx] {
+		if n.kind.isAsync() {
+			continue
+		}
+
+		if previous != "" {
+			result = append(result, [2]string{previous, n.id})
+		}
+
+		previous = n.id
+	}
+
+	return result
+}
+
+// dependencyEdges returns the edges from each executor to the executors depending on it. Dependencies
+// on executors that are not part of the flow are left out.
+func (d flowDiagram) dependencyEdges() [][2]string {
+	var result [][2]string
+
+	for _, layer := range d.layers {
+		for _, n := range layer {
+			for _, dependency := range n.executor.dependencies() {
+				if id, ok := d.idOf(dependency); ok {
+					result = append(result, [2]string{id, n.id})
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+func (d flowDiagram) idOf(e IExecutor) (string, bool) {
+	for _, t := range e.phaseTasks() {
+		if id, ok := d.ids[t]; ok {
+			return id, true
+		}
+	}
+
+	return "", false
+}
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+
+	return `"` + s + `"`
+}
+
+func mermaidQuote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, "#quot;") + `"`
+}
@@ -0,0 +1,136 @@
+package component
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecutionFlow_Diagrams(t *testing.T) {
+	newFlow := func() ExecutionFlow {
+		routing, _ := CreateAsyncExecutor[int](
+			asyncComponentFunc[int](
+				func(ctx context.Context) (int, error) {
+					return 1, nil
+				},
+			),
+			WithName("routing"),
+		)
+
+		surge, _ := CreateAsyncExecutor[int](
+			asyncComponentFunc[int](
+				func(ctx context.Context) (int, error) {
+					return 1, nil
+				},
+			),
+			WithName("surge"),
+		)
+
+		fare, _ := CreateSyncExecutorWithLoading[int, int](
+			&MockSyncComponentWithLoading[int, int]{},
+			WithName(`fare "v2"`),
+			DependsOn(routing, surge),
+		)
+
+		// Dependencies outside of the flow are left out
+		outsider := CreateSyncOrchestratingExecutor(func(ctx context.Context) error { return nil })
+
+		rounding := CreateSyncOrchestratingExecutor(
+			func(ctx context.Context) error { return nil },
+			DependsOn(fare, outsider),
+		)
+
+		return NewExecutionFlowBuilder().
+			Append(routing, surge, fare).
+			NextLayer().
+			Append(rounding).
+			WithName("fare_calculation").
+			Get()
+	}
+
+	scenarios := []struct {
+		desc     string
+		render   func(f ExecutionFlow) string
+		expected string
+	}{
+		{
+			desc:   "DOT",
+			render: ExecutionFlow.ToDOT,
+			expected: `digraph "fare_calculation" {
+	rankdir=LR;
+	node [fontname="Helvetica"];
+
+	subgraph cluster_layer_0 {
+		label="layer 0";
+		executor_0_0 [label="routing\nasync", shape=ellipse];
+		executor_0_1 [label="surge\nasync", shape=ellipse];
+		executor_0_2 [label="fare \"v2\"\nsync_with_loading", shape=box];
+	}
+
+	subgraph cluster_layer_1 {
+		label="layer 1";
+		executor_1_0 [label="executor 1-0\nsync", shape=box];
+	}
+
+	executor_0_0 -> executor_0_2 [style=dashed];
+	executor_0_1 -> executor_0_2 [style=dashed];
+	executor_0_2 -> executor_1_0 [style=dashed];
+}
+`,
+		},
+		{
+			desc:   "Mermaid",
+			render: ExecutionFlow.ToMermaid,
+			expected: `flowchart LR
+	subgraph layer_0 ["layer 0"]
+		executor_0_0(["routing<br/>async"])
+		executor_0_1(["surge<br/>async"])
+		executor_0_2["fare #quot;v2#quot;<br/>sync_with_loading"]
+	end
+	subgraph layer_1 ["layer 1"]
+		executor_1_0["executor 1-0<br/>sync"]
+	end
+	executor_0_0 -.-> executor_0_2
+	executor_0_1 -.-> executor_0_2
+	executor_0_2 -.-> executor_1_0
+`,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(
+			sc.desc, func(t *testing.T) {
+				assert.Equal(t, sc.expected, sc.render(newFlow()))
+			},
+		)
+	}
+}
+
+func TestFlowDiagram_SyncLaneEdges(t *testing.T) {
+	syncExecutor := func() Executor[any] {
+		return CreateSyncOrchestratingExecutor(func(ctx context.Context) error { return nil })
+	}
+
+	asyncExecutor, _ := CreateAsyncExecutor[int](
+		asyncComponentFunc[int](
+			func(ctx context.Context) (int, error) {
+				return 1, nil
+			},
+		),
+	)
+
+	flow := NewExecutionFlowBuilder().
+		Append(syncExecutor(), asyncExecutor, syncExecutor(), syncExecutor()).
+		Get()
+
+	expected := [][2]string{
+		{"executor_0_0", "executor_0_2"},
+		{"executor_0_2", "executor_0_3"},
+	}
+
+	assert.Equal(t, expected, newFlowDiagram(flow).syncLaneEdges(0))
+	assert.Contains(t, flow.ToMermaid(), "\t\texecutor_0_0 --> executor_0_2\n")
+	assert.Contains(t, flow.ToDOT(), "\t\texecutor_0_2 -> executor_0_3;\n")
+}
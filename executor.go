@@ -2,29 +2,49 @@ package component
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/jamestrandung/go-concurrency/v2/async"
 )
 
+// ErrCancelled is wrapped by the errors of executors that got cancelled, including
+// the causes given to CustomExecutor.Cancel. The errors returned by a CustomExecutor
+// that got cancelled must wrap it.
+var ErrCancelled = errors.New("executor cancelled")
+
+// IExecutor represents the executors that can be appended to an ExecutionFlow.
+// New kinds of executors can be plugged into flows by implementing
+// CustomExecutor & wrapping it using CreateCustomExecutor.
+//
 //go:generate mockery --name IExecutor --case underscore --inpackage
 type IExecutor interface {
 	invokeSyncTask(ctx context.Context) error
 	canBeInvokedAsync() bool
 	invokeAsyncTask(ctx context.Context) error
 	cancel(err error)
+	kind() ExecutorKind
+	Name() string
+	isNameExplicit() bool
+	ComponentType() string
+	Tags() map[string]string
+	phaseTasks() map[Phase]any
+	dependencies() []IExecutor
 	InvokeExecutingTask(ctx context.Context) error
 }
 
 // ExecutorWithLoading encapsulates the tasks that need to be executed to carry
-// out the business logic of a synchronous component with loading logic.
+// out the business logic of a component with loading logic.
 type ExecutorWithLoading[V any, T any] struct {
-	loadingTask       async.Task[V]
-	executingSyncTask async.Task[T]
+	executorMetadata
+	loadingTask        async.Task[V]
+	executingSyncTask  async.Task[T]
+	executingAsyncTask async.Task[T]
 }
 
 func (e ExecutorWithLoading[V, T]) invokeSyncTask(ctx context.Context) error {
 	if e.executingSyncTask != nil {
-		return e.executingSyncTask.ExecuteSync(ctx).Error()
+		return errorOf(e.executingSyncTask.ExecuteSync(ctx))
 	}
 
 	return nil
@@ -35,6 +55,12 @@ func (e ExecutorWithLoading[V, T]) canBeInvokedAsync() bool {
 }
 
 func (e ExecutorWithLoading[V, T]) invokeAsyncTask(ctx context.Context) error {
+	// Errors from async tasks will stop the entire flow, the loading
+	// task gets started by the flow or by the executing task itself
+	if e.executingAsyncTask != nil {
+		return errorOf(e.executingAsyncTask.ExecuteSync(ctx))
+	}
+
 	// Errors from loading task will be handled by sync components
 	if e.loadingTask != nil {
 		e.loadingTask.ExecuteSync(ctx)
@@ -45,6 +71,16 @@ func (e ExecutorWithLoading[V, T]) invokeAsyncTask(ctx context.Context) error {
 	return nil
 }
 
+// loadingTasks returns the loading task of async executors, which the flow starts ahead of
+// the executing task. Sync executors have their loading task started by invokeAsyncTask.
+func (e ExecutorWithLoading[V, T]) loadingTasks() []async.SilentTask {
+	if e.executingAsyncTask == nil || e.loadingTask == nil {
+		return nil
+	}
+
+	return []async.SilentTask{e.loadingTask}
+}
+
 func (e ExecutorWithLoading[V, T]) cancel(err error) {
 	if e.loadingTask != nil {
 		e.loadingTask.CancelWithReason(err)
@@ -53,26 +89,59 @@ func (e ExecutorWithLoading[V, T]) cancel(err error) {
 	if e.executingSyncTask != nil {
 		e.executingSyncTask.CancelWithReason(err)
 	}
+
+	if e.executingAsyncTask != nil {
+		e.executingAsyncTask.CancelWithReason(err)
+	}
+}
+
+func (e ExecutorWithLoading[V, T]) kind() ExecutorKind {
+	if e.executingAsyncTask != nil {
+		return KindAsyncWithLoading
+	}
+
+	return KindSyncWithLoading
+}
+
+func (e ExecutorWithLoading[V, T]) phaseTasks() map[Phase]any {
+	if e.executingAsyncTask != nil {
+		return map[Phase]any{
+			PhaseLoad:         e.loadingTask,
+			PhaseExecuteAsync: e.executingAsyncTask,
+		}
+	}
+
+	return map[Phase]any{
+		PhaseLoad:        e.loadingTask,
+		PhaseExecuteSync: e.executingSyncTask,
+	}
 }
 
 func (e ExecutorWithLoading[V, T]) InvokeExecutingTask(ctx context.Context) error {
-	return e.GetExecutingTask().ExecuteSync(ctx).Error()
+	return errorOf(e.GetExecutingTask().ExecuteSync(ctx))
 }
 
 func (e ExecutorWithLoading[V, T]) GetExecutingTask() async.Task[T] {
-	return e.executingSyncTask
+	// ExecutorWithLoading must contain either executingSyncTask or
+	// executingAsyncTask but not both at the same time.
+	if e.executingSyncTask != nil {
+		return e.executingSyncTask
+	}
+
+	return e.executingAsyncTask
 }
 
 // Executor encapsulates the tasks that need to be executed to carry
 // out the business logic of a component without loading logic.
 type Executor[T any] struct {
+	executorMetadata
 	executingSyncTask  async.Task[T]
 	executingAsyncTask async.Task[T]
 }
 
 func (e Executor[T]) invokeSyncTask(ctx context.Context) error {
 	if e.executingSyncTask != nil {
-		return e.executingSyncTask.ExecuteSync(ctx).Error()
+		return errorOf(e.executingSyncTask.ExecuteSync(ctx))
 	}
 
 	return nil
@@ -85,7 +154,7 @@ func (e Executor[T]) canBeInvokedAsync() bool {
 func (e Executor[T]) invokeAsyncTask(ctx context.Context) error {
 	// Errors from async tasks will stop the entire flow
 	if e.executingAsyncTask != nil {
-		return e.executingAsyncTask.ExecuteSync(ctx).Error()
+		return errorOf(e.executingAsyncTask.ExecuteSync(ctx))
 	}
 
 	return nil
@@ -101,8 +170,28 @@ func (e Executor[T]) cancel(err error) {
 	}
 }
 
+func (e Executor[T]) kind() ExecutorKind {
+	if e.executingAsyncTask != nil {
+		return KindAsync
+	}
+
+	return KindSync
+}
+
+func (e Executor[T]) phaseTasks() map[Phase]any {
+	if e.executingAsyncTask != nil {
+		return map[Phase]any{
+			PhaseExecuteAsync: e.executingAsyncTask,
+		}
+	}
+
+	return map[Phase]any{
+		PhaseExecuteSync: e.executingSyncTask,
+	}
+}
+
 func (e Executor[T]) InvokeExecutingTask(ctx context.Context) error {
-	return e.GetExecutingTask().ExecuteSync(ctx).Error()
+	return errorOf(e.GetExecutingTask().ExecuteSync(ctx))
 }
 
 func (e Executor[T]) GetExecutingTask() async.Task[T] {
@@ -114,3 +203,52 @@ func (e Executor[T]) GetExecutingTask() async.Task[T] {
 
 	return e.executingAsyncTask
 }
+
+// cancelReason returns the reason given to the executors cancelled because of the given error.
+func cancelReason(err error) error {
+	if err == nil {
+		return ErrCancelled
+	}
+
+	if errors.Is(err, ErrCancelled) {
+		return err
+	}
+
+	return fmt.Errorf("%w: %w", ErrCancelled, err)
+}
+
+// isCancellation returns whether the given error comes from an executor being cancelled.
+func isCancellation(err error) bool {
+	return errors.Is(err, ErrCancelled)
+}
+
+// cancelledError is the error of a task that got cancelled. The async package keeps
+// only the text of the reasons of cancellations, cancelledError keeps this text &
+// makes the error match ErrCancelled.
+type cancelledError struct {
+	err error
+}
+
+func (e *cancelledError) Error() string {
+	return e.err.Error()
+}
+
+func (e *cancelledError) Unwrap() []error {
+	return []error{ErrCancelled, e.err}
+}
+
+// errorOf returns the error of the given task, which wraps ErrCancelled if the task got cancelled.
+func errorOf(task async.SilentTask) error {
+	err := task.Error()
+	if err != nil && task.State() == async.IsCancelled {
+		return &cancelledError{err}
+	}
+
+	return err
+}
+
+// outcomeOf works like task.Outcome() but the error wraps ErrCancelled if the task got cancelled.
+func outcomeOf[T any](task async.Task[T]) (T, error) {
+	result, _ := task.Outcome()
+	return result, errorOf(task)
+}
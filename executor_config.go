@@ -0,0 +1,205 @@
+package component
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+type executorConfigs struct {
+	name              string
+	nameIsExplicit    bool
+	componentType     string
+	tags              map[string]string
+	singleFlightGroup *SingleFlightGroup
+	singleFlightKey   string
+	dependencies      []IExecutor
+	loadTimeout       time.Duration
+	executeTimeout    time.Duration
+}
+
+// ExecutorOption customizes an executor when it gets created.
+type ExecutorOption func(*executorConfigs)
+
+// newExecutorConfigs returns the configs of an executor of the given component, which
+// is nil for executors created from functions instead of components.
+func newExecutorConfigs(component any, options []ExecutorOption) *executorConfigs {
+	configs := &executorConfigs{
+		componentType: typeNameOf(component),
+	}
+
+	for _, o := range options {
+		o(configs)
+	}
+
+	if configs.name == "" {
+		configs.name = configs.componentType
+	}
+
+	return configs
+}
+
+// executorMetadata gives executors of all kinds access to the metadata in their configs,
+// which are nil for executors not created using the Create functions.
+type executorMetadata struct {
+	configs *executorConfigs
+}
+
+// Name returns the name identifying this executor.
+func (m executorMetadata) Name() string {
+	if m.configs == nil {
+		return ""
+	}
+
+	return m.configs.name
+}
+
+// isNameExplicit returns whether the name of this executor was given via WithName.
+func (m executorMetadata) isNameExplicit() bool {
+	return m.configs != nil && m.configs.nameIsExplicit
+}
+
+// ComponentType returns the type of component encapsulated by this executor.
+func (m executorMetadata) ComponentType() string {
+	if m.configs == nil {
+		return ""
+	}
+
+	return m.configs.componentType
+}
+
+// Tags returns a copy of the tags attached to this executor.
+func (m executorMetadata) Tags() map[string]string {
+	if m.configs == nil || m.configs.tags == nil {
+		return nil
+	}
+
+	result := make(map[string]string, len(m.configs.tags))
+	for k, v := range m.configs.tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+func (m executorMetadata) dependencies() []IExecutor {
+	if m.configs == nil {
+		return nil
+	}
+
+	return m.configs.dependencies
+}
+
+// typeNameOf returns the name of the Go type of the given value, e.g. routing.Component.
+func typeNameOf(v any) string {
+	if v == nil {
+		return ""
+	}
+
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t.String()
+}
+
+// unnamed is the name shown for flows & executors without a name.
+const unnamed = "unnamed"
+
+// labelOrUnnamed returns the given name of a flow or an executor, or unnamed if it's empty.
+func labelOrUnnamed(name string) string {
+	if name == "" {
+		return unnamed
+	}
+
+	return name
+}
+
+// WithName sets the name identifying the executor in errors, reports, logs, traces &
+// metrics. By default, executors of components are named after the component type.
+// Names given explicitly must be unique within a flow, see ExecutionFlowBuilder.Build.
+func WithName(name string) ExecutorOption {
+	return func(configs *executorConfigs) {
+		configs.name = name
+		configs.nameIsExplicit = name != ""
+	}
+}
+
+// WithComponentType sets the type of component encapsulated by the executor. By
+// default, it's the name of the Go type of the component, e.g. routing.Component.
+func WithComponentType(componentType string) ExecutorOption {
+	return func(configs *executorConfigs) {
+		configs.componentType = componentType
+	}
+}
+
+// WithTags attaches the given tags, such as the team owning the component or
+// its criticality, to the executor. Tags with the same keys get overwritten.
+func WithTags(tags map[string]string) ExecutorOption {
+	return func(configs *executorConfigs) {
+		if configs.tags == nil {
+			configs.tags = make(map[string]string, len(tags))
+		}
+
+		for k, v := range tags {
+			configs.tags[k] = v
+		}
+	}
+}
+
+// WithSingleFlight makes the executor share the in-flight call of the
+// component with all other executors using the same group & key. This
+// applies to Execute of AsyncComponent and Load of components with loading.
+//
+// Engineers must make sure a key uniquely identifies the inputs of the
+// call (e.g. geohash & minute for surge) as well as its result type.
+func WithSingleFlight(group *SingleFlightGroup, key string) ExecutorOption {
+	return func(configs *executorConfigs) {
+		configs.singleFlightGroup = group
+		configs.singleFlightKey = key
+	}
+}
+
+// DependsOn declares the executors whose futures are read by the executor. It lets
+// diagrams of an ExecutionFlow show these dependencies. Executors of components
+// with loading that run asynchronously also wait for these executors to complete
+// before executing their main logic.
+func DependsOn(executors ...IExecutor) ExecutorOption {
+	return func(configs *executorConfigs) {
+		configs.dependencies = append(configs.dependencies, executors...)
+	}
+}
+
+// WithLoadTimeout bounds the time the loading task of the executor may take. The
+// context given to Load gets cancelled after the timeout, components must return
+// once it's done. The phase is then reported as StatusTimedOut.
+func WithLoadTimeout(timeout time.Duration) ExecutorOption {
+	return func(configs *executorConfigs) {
+		configs.loadTimeout = timeout
+	}
+}
+
+// WithExecuteTimeout bounds the time the executing task of the executor may take,
+// excluding the time spent waiting for its loading task. The context given to the
+// component gets cancelled after the timeout, components must return once it's
+// done. The phase is then reported as StatusTimedOut.
+func WithExecuteTimeout(timeout time.Duration) ExecutorOption {
+	return func(configs *executorConfigs) {
+		configs.executeTimeout = timeout
+	}
+}
+
+// withTimeout returns a function calling fn with a context bounded by the given timeout, if any.
+func withTimeout[T any](timeout time.Duration, fn func(ctx context.Context) (T, error)) func(ctx context.Context) (T, error) {
+	if timeout <= 0 {
+		return fn
+	}
+
+	return func(ctx context.Context) (T, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return fn(ctx)
+	}
+}
@@ -0,0 +1,54 @@
+package component
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+)
+
+// FinallyExecutor runs after an execution flow completes, whether it succeeded, failed
+// or got cancelled, including flows without executors. It receives the error returned by
+// the flow, if any, together with the ExecutionReport describing what happened to each
+// executor, e.g. to release locks, emit audit events or persist partial results.
+//
+// Finally executors run on a separate goroutine once the executors still running when
+// the flow completes have returned, which may happen after the flow failed fast. The
+// flow returns to its caller without waiting for them & no executors of the flow start
+// after that.
+//
+// The given context keeps the values of the context given to the flow but does not get
+// cancelled with it. Errors returned by finally executors are logged using LoggerFrom
+// and do not change the outcome of the flow.
+type FinallyExecutor func(ctx context.Context, err error, report ExecutionReport) error
+
+// runFinally starts running the finally executors of the flow sequentially in the order
+// they were registered, once the phases of all executors have returned. A failing or
+// panicking finally executor does not prevent the next ones from running.
+func (r *flowRun) runFinally(ctx context.Context, err error) {
+	if len(r.flow.finally) == 0 {
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+
+	go func() {
+		r.settle()
+
+		report := r.report()
+		for idx, fn := range r.flow.finally {
+			if finallyErr := invokeFinally(ctx, fn, err, report); finallyErr != nil {
+				LoggerFrom(ctx).Error("finally executor failed", LogKeyFinallyIdx, idx, "error", finallyErr)
+			}
+		}
+	}()
+}
+
+func invokeFinally(ctx context.Context, fn FinallyExecutor, err error, report ExecutionReport) (finallyErr error) {
+	defer func() {
+		if r := recover(); r != nil {
+			finallyErr = fmt.Errorf("panic executing finally executor: %v \n %s", r, debug.Stack())
+		}
+	}()
+
+	return fn(ctx, err, report)
+}
@@ -0,0 +1,299 @@
+package component
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFinally(t *testing.T) {
+	succeeding := func(name string) IExecutor {
+		return CreateSyncOrchestratingExecutor(
+			func(ctx context.Context) error {
+				return nil
+			},
+			WithName(name),
+		)
+	}
+
+	type call struct {
+		err      error
+		statuses map[string]Status
+	}
+
+	recordingTo := func(calls chan<- call) FinallyExecutor {
+		return func(ctx context.Context, err error, report ExecutionReport) error {
+			assert.Nil(t, ctx.Err(), "finally executors must not get cancelled with the flow")
+
+			statuses := make(map[string]Status, len(report.Executors))
+			for _, r := range report.Executors {
+				statuses[r.Name] = r.Status
+			}
+
+			calls <- call{
+				err:      err,
+				statuses: statuses,
+			}
+
+			return nil
+		}
+	}
+
+	// Finally executors run asynchronously after the flow returns
+	received := func(t *testing.T, calls <-chan call) call {
+		select {
+		case c := <-calls:
+			return c
+		case <-time.After(time.Second):
+			t.Fatal("finally executors did not run")
+			return call{}
+		}
+	}
+
+	scenarios := []struct {
+		desc string
+		test func(t *testing.T)
+	}{
+		{
+			desc: "finally executors run after a successful flow",
+			test: func(t *testing.T) {
+				calls := make(chan call, 1)
+
+				flow := NewExecutionFlowBuilder().
+					Append(succeeding("first")).
+					NextLayer().
+					Append(succeeding("second")).
+					Finally(recordingTo(calls)).
+					Get()
+
+				err := ForkJoinFailingFast(context.Background(), flow)
+				assert.Nil(t, err)
+
+				expected := call{
+					statuses: map[string]Status{
+						"first":  StatusSucceeded,
+						"second": StatusSucceeded,
+					},
+				}
+
+				assert.Equal(t, expected, received(t, calls))
+			},
+		},
+		{
+			desc: "finally executors run after a failed flow & see which executors completed",
+			test: func(t *testing.T) {
+				calls := make(chan call, 1)
+
+				first, firstFuture := CreateSyncOrchestratingExecutorWithResult(
+					func(ctx context.Context) (int, error) {
+						return 1, nil
+					},
+					WithName("first"),
+				)
+
+				second := CreateSyncOrchestratingExecutor(
+					func(ctx context.Context) error {
+						_, _ = firstFuture.Get(ctx)
+						return assert.AnError
+					},
+					WithName("second"),
+				)
+
+				flow := NewExecutionFlowBuilder().
+					Append(first).
+					NextLayer().
+					Append(second).
+					Finally(recordingTo(calls)).
+					Get()
+
+				err := ForkJoinFailingFast(context.Background(), flow)
+				assert.Equal(t, &ExecutorError{Executor: "second", Err: assert.AnError}, err)
+
+				c := received(t, calls)
+				assert.Equal(t, err, c.err)
+				assert.Equal(t, StatusSucceeded, c.statuses["first"])
+				assert.Equal(t, StatusFailed, c.statuses["second"])
+			},
+		},
+		{
+			desc: "flows fail fast without waiting for executors still running & finally executors wait for them",
+			test: func(t *testing.T) {
+				calls := make(chan call, 1)
+
+				var returned atomic.Bool
+
+				started := make(chan struct{})
+				release := make(chan struct{})
+
+				slow, _ := CreateAsyncExecutor[int](
+					asyncComponentFunc[int](
+						func(ctx context.Context) (int, error) {
+							close(started)
+
+							// Components may ignore cancellations, e.g. while holding a lock
+							<-release
+							returned.Store(true)
+
+							return 1, nil
+						},
+					),
+					WithName("slow"),
+				)
+
+				failing := CreateSyncOrchestratingExecutor(
+					func(ctx context.Context) error {
+						<-started
+						return assert.AnError
+					},
+					WithName("failing"),
+				)
+
+				flow := NewExecutionFlowBuilder().
+					Append(slow, failing).
+					Finally(
+						func(ctx context.Context, err error, report ExecutionReport) error {
+							assert.True(t, returned.Load(), "finally executors must run after all executors returned")
+							return nil
+						},
+						recordingTo(calls),
+					).
+					Get()
+
+				err := ForkJoinFailingFast(context.Background(), flow)
+				assert.Equal(t, &ExecutorError{Executor: "failing", Err: assert.AnError}, err)
+				assert.Empty(t, calls)
+
+				close(release)
+
+				c := received(t, calls)
+				assert.Equal(t, StatusFailed, c.statuses["failing"])
+				assert.Equal(t, StatusSucceeded, c.statuses["slow"], "the report is taken once slow returned")
+			},
+		},
+		{
+			desc: "flows return once their context is done without waiting for finally executors",
+			test: func(t *testing.T) {
+				calls := make(chan call, 1)
+				release := make(chan struct{})
+
+				slow := CreateSyncOrchestratingExecutor(
+					func(ctx context.Context) error {
+						<-release
+						return nil
+					},
+					WithName("slow"),
+				)
+
+				flow := NewExecutionFlowBuilder().
+					Append(slow).
+					Finally(recordingTo(calls)).
+					Get()
+
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+				defer cancel()
+
+				err := ForkJoinFailingFast(ctx, flow)
+				assert.Equal(t, context.DeadlineExceeded, err)
+				assert.Empty(t, calls)
+
+				close(release)
+
+				c := received(t, calls)
+				assert.Equal(t, context.DeadlineExceeded, c.err)
+				assert.Equal(t, StatusSucceeded, c.statuses["slow"])
+			},
+		},
+		{
+			desc: "finally executors run after a cancelled flow",
+			test: func(t *testing.T) {
+				calls := make(chan call, 1)
+
+				flow := NewExecutionFlowBuilder().
+					Append(succeeding("first")).
+					Finally(recordingTo(calls)).
+					Get()
+
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				err := ForkJoinFailingFast(ctx, flow)
+				assert.Equal(t, context.Canceled, err)
+
+				assert.Equal(t, context.Canceled, received(t, calls).err)
+			},
+		},
+		{
+			desc: "finally executors run after an empty flow",
+			test: func(t *testing.T) {
+				calls := make(chan call, 1)
+
+				flow := ExecutionFlow{
+					finally: []FinallyExecutor{recordingTo(calls)},
+				}
+
+				err := ForkJoinFailingFast(context.Background(), flow)
+				assert.Nil(t, err)
+				assert.Equal(t, call{statuses: map[string]Status{}}, received(t, calls))
+			},
+		},
+		{
+			desc: "finally executors run after a built flow without executors",
+			test: func(t *testing.T) {
+				calls := make(chan call, 1)
+
+				flow, err := NewExecutionFlowBuilder().
+					Finally(recordingTo(calls)).
+					Build()
+				assert.Nil(t, err)
+
+				err = ForkJoinFailingFast(context.Background(), flow)
+				assert.Nil(t, err)
+				assert.Equal(t, call{statuses: map[string]Status{}}, received(t, calls))
+			},
+		},
+		{
+			desc: "finally executors run in order even if some of them fail or panic",
+			test: func(t *testing.T) {
+				var order []int
+
+				done := make(chan struct{})
+
+				flow := NewExecutionFlowBuilder().
+					Append(succeeding("first")).
+					Finally(
+						func(ctx context.Context, err error, report ExecutionReport) error {
+							order = append(order, 1)
+							return assert.AnError
+						},
+						func(ctx context.Context, err error, report ExecutionReport) error {
+							order = append(order, 2)
+							panic("release failed")
+						},
+					).
+					Finally(
+						func(ctx context.Context, err error, report ExecutionReport) error {
+							order = append(order, 3)
+							close(done)
+
+							return nil
+						},
+					).
+					Get()
+
+				err := ForkJoinFailingFast(context.Background(), flow)
+				assert.Nil(t, err, "finally executors do not change the outcome of the flow")
+
+				<-done
+				assert.Equal(t, []int{1, 2, 3}, order)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(sc.desc, sc.test)
+	}
+}
@@ -0,0 +1,250 @@
+package component
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// flowRun holds the states of one execution of an ExecutionFlow.
+type flowRun struct {
+	flow         ExecutionFlow
+	spawn        spawnFunc
+	tracer       trace.Tracer
+	span         trace.Span
+	logger       *slog.Logger
+	recorder     *executionRecorder
+	recording    bool
+	interceptors []Interceptor
+
+	producersOnce sync.Once
+	producers     map[any]phaseKey
+
+	mu                    sync.Mutex
+	cancelledFromLayerIdx int
+	firstCancelCause      error
+
+	phasesMu      sync.Mutex
+	runningPhases int
+	settled       bool
+	idle          chan struct{}
+}
+
+// newFlowRun returns a run of the given flow. Phases are recorded only if withReport
+// is true or the flow has finally executors, which are the consumers of its report.
+func newFlowRun(flow ExecutionFlow, withReport bool) *flowRun {
+	r := &flowRun{
+		flow:      flow,
+		tracer:    newTracer(flow.tracerProvider),
+		recorder:  newExecutionRecorder(),
+		recording: withReport || len(flow.finally) > 0,
+	}
+
+	global := getGlobalInterceptors()
+
+	// Built-in instrumentation runs first so that user-defined
+	// interceptors can observe its effects on the context.
+	r.interceptors = make([]Interceptor, 0, 4+len(global)+len(flow.interceptors))
+
+	if r.tracer != nil {
+		r.interceptors = append(r.interceptors, r.tracingInterceptor())
+	}
+
+	if r.recording {
+		r.interceptors = append(r.interceptors, r.recordingInterceptor())
+	}
+
+	if flow.logging != nil {
+		r.interceptors = append(r.interceptors, r.loggingInterceptor())
+	}
+
+	if flow.metrics != nil {
+		r.interceptors = append(r.interceptors, flow.metrics.interceptor(flow.name))
+	}
+
+	r.interceptors = append(r.interceptors, global...)
+	r.interceptors = append(r.interceptors, flow.interceptors...)
+
+	return r
+}
+
+// start prepares this run for execution & returns the context for executing the flow.
+func (r *flowRun) start(ctx context.Context) context.Context {
+	r.recorder.start()
+
+	ctx, r.span = r.startFlowSpan(ctx)
+	ctx = r.startLogging(ctx)
+	r.spawn = r.flow.spawner(ctx)
+
+	if r.flow.metrics != nil {
+		r.flow.metrics.flowStarted(r.flow.name)
+	}
+
+	return ctx
+}
+
+// finish wraps up this run using the final outcome of the flow.
+func (r *flowRun) finish(err error) {
+	r.recorder.finish(err)
+	if r.flow.metrics != nil {
+		r.flow.metrics.flowFinished(r.flow.name)
+	}
+
+	r.finishLogging(err)
+	endSpan(r.span, err)
+}
+
+// enterPhase records a phase of an executor starting in this run. It returns false
+// if the run has already settled, in which case the phase must not be carried out.
+func (r *flowRun) enterPhase() bool {
+	r.phasesMu.Lock()
+	defer r.phasesMu.Unlock()
+
+	if r.settled {
+		return false
+	}
+
+	r.runningPhases = r.runningPhases + 1
+
+	return true
+}
+
+// leavePhase records a phase started via enterPhase returning.
+func (r *flowRun) leavePhase() {
+	r.phasesMu.Lock()
+	defer r.phasesMu.Unlock()
+
+	r.runningPhases = r.runningPhases - 1
+	if r.runningPhases == 0 && r.idle != nil {
+		close(r.idle)
+		r.idle = nil
+	}
+}
+
+// settle prevents new phases from starting in this run & blocks until the running
+// ones return, which may happen after the flow failed fast & cancelled their tasks.
+func (r *flowRun) settle() {
+	r.phasesMu.Lock()
+	r.settled = true
+
+	if r.runningPhases == 0 {
+		r.phasesMu.Unlock()
+		return
+	}
+
+	idle := make(chan struct{})
+	r.idle = idle
+	r.phasesMu.Unlock()
+
+	<-idle
+}
+
+// recordCancellation keeps track of the first error that made this run cancel its
+// tasks as well as the first layer from which executors got cancelled.
+func (r *flowRun) recordCancellation(layerIdx int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.firstCancelCause == nil {
+		r.firstCancelCause = err
+		r.cancelledFromLayerIdx = layerIdx
+
+		return
+	}
+
+	if layerIdx < r.cancelledFromLayerIdx {
+		r.cancelledFromLayerIdx = layerIdx
+	}
+}
+
+// cancellation returns the first layer from which executors got cancelled
+// and the cause of this cancellation, if any.
+func (r *flowRun) cancellation() (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cancelledFromLayerIdx, r.firstCancelCause
+}
+
+// cancelCause returns the reason why tasks invoked using the given context got
+// cancelled, which is either an error from another task or the flow context.
+func (r *flowRun) cancelCause(ctx context.Context) error {
+	if _, cause := r.cancellation(); cause != nil {
+		return cause
+	}
+
+	return context.Cause(ctx)
+}
+
+type invocationScopeKey struct{}
+
+// invocationScope identifies the executor being invoked by a flow run.
+type invocationScope struct {
+	run         *flowRun
+	executor    IExecutor
+	layerIdx    int
+	executorIdx int
+	phase       Phase
+}
+
+// withInvocationScope returns a context carrying the scope of the executor
+// at the given position so that its tasks know how they are being invoked.
+func (r *flowRun) withInvocationScope(ctx context.Context, layerIdx int, executorIdx int) context.Context {
+	return context.WithValue(
+		ctx,
+		invocationScopeKey{},
+		invocationScope{
+			run:         r,
+			executor:    r.flow.Executors[layerIdx][executorIdx],
+			layerIdx:    layerIdx,
+			executorIdx: executorIdx,
+		},
+	)
+}
+
+func invocationScopeFrom(ctx context.Context) (invocationScope, bool) {
+	s, ok := ctx.Value(invocationScopeKey{}).(invocationScope)
+	return s, ok
+}
+
+// withoutFlowScope returns a context keeping the values of ctx except the scope of the
+// executor being invoked & the current span, for work outliving the flow run of ctx.
+func withoutFlowScope(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, invocationScopeKey{}, nil)
+
+	return trace.ContextWithSpanContext(ctx, trace.SpanContext{})
+}
+
+// withPhase returns a context carrying the scope of the executor being invoked
+// in ctx, narrowed down to the given phase. Outside of a flow, ctx is returned.
+func withPhase(ctx context.Context, phase Phase) context.Context {
+	s, ok := invocationScopeFrom(ctx)
+	if !ok {
+		return ctx
+	}
+
+	s.phase = phase
+
+	return context.WithValue(ctx, invocationScopeKey{}, s)
+}
+
+// key returns the key identifying the phase being invoked in this scope.
+func (s invocationScope) key() phaseKey {
+	return phaseKey{
+		layerIdx:    s.layerIdx,
+		executorIdx: s.executorIdx,
+		phase:       s.phase,
+	}
+}
+
+func (s invocationScope) invocation(phase Phase) Invocation {
+	return Invocation{
+		Executor:    s.executor,
+		Kind:        s.executor.kind(),
+		Phase:       phase,
+		LayerIdx:    s.layerIdx,
+		ExecutorIdx: s.executorIdx,
+	}
+}
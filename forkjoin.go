@@ -2,35 +2,88 @@ package component
 
 import (
 	"context"
-	"strings"
+	"fmt"
 	"sync"
+	"sync/atomic"
+
+	"github.com/jamestrandung/go-concurrency/v2/async"
 )
 
+// ExecutorError is returned by ForkJoinFailingFast when an executor in the flow fails.
+// Executor is the name of this executor, or its position for executors without a name,
+// e.g. "executor 1-0" for the first executor of the second layer, & Err is the error it
+// returned.
+type ExecutorError struct {
+	Executor string
+	Err      error
+}
+
+func (e *ExecutorError) Error() string {
+	return fmt.Sprintf("executor %q failed: %v", e.Executor, e.Err)
+}
+
+func (e *ExecutorError) Unwrap() error {
+	return e.Err
+}
+
+func executorError(e IExecutor, layerIdx int, executorIdx int, err error) error {
+	return &ExecutorError{
+		Executor: executorLabel(e.Name(), layerIdx, executorIdx),
+		Err:      err,
+	}
+}
+
 // ForkJoinFailingFast invokes the executors in the given ExecutionFlow based on its type. If an executor comes from
 // an async component, it will be executed asynchronously. If an executor comes from a sync component, its loading
 // task will be executed asynchronously while its executing task will be executed synchronously based on the order
 // of the given tasks.
 //
 // If any of the executing tasks of async or sync components returns an error, the function will stop immediately
-// and return this error to the caller.
+// and return this error, wrapped in an ExecutorError naming the failing executor, to the caller.
 var ForkJoinFailingFast = func(ctx context.Context, flow ExecutionFlow) error {
+	_, err := forkJoinFailingFast(ctx, flow, false)
+	return err
+}
+
+// ForkJoinFailingFastWithReport works exactly like ForkJoinFailingFast. In addition to the error, it returns an
+// ExecutionReport describing what happened to each executor in the given ExecutionFlow.
+func ForkJoinFailingFastWithReport(ctx context.Context, flow ExecutionFlow) (ExecutionReport, error) {
+	run, err := forkJoinFailingFast(ctx, flow, true)
+	return run.report(), err
+}
+
+func forkJoinFailingFast(ctx context.Context, flow ExecutionFlow, withReport bool) (*flowRun, error) {
+	run := newFlowRun(flow, withReport)
+
 	if len(flow.Executors) == 0 {
-		return nil
-	}
+		run.recorder.start()
+		run.recorder.finish(nil)
 
-	if len(flow.Executors) == 1 {
-		return doForkJoinFailingFast(ctx, flow, 0)
+		// Finally executors run even for flows without executors
+		run.runFinally(ctx, nil)
+
+		return run, nil
 	}
 
-	// Buffer of len(flow.Executors) to take at most 1 error from each layer of executors
+	ctx = run.start(ctx)
+
+	// Buffer of len(flow.Executors) to take exactly 1 outcome from each layer of executors
 	errChan := make(chan error, len(flow.Executors))
 
 	for i := 0; i < len(flow.Executors); i++ {
-		go func(idx int) {
-			errChan <- doForkJoinFailingFast(ctx, flow, idx)
-		}(i)
+		doForkJoinFailingFast(ctx, run, i, errChan)
 	}
 
+	err := joinFailingFast(ctx, len(flow.Executors), errChan)
+	run.finish(err)
+	run.runFinally(ctx, err)
+
+	return run, err
+}
+
+// joinFailingFast blocks until all layers complete successfully or until
+// the first error, whichever happens first.
+func joinFailingFast(ctx context.Context, layerCount int, errChan <-chan error) error {
 	done := 0
 
 	for {
@@ -38,7 +91,7 @@ var ForkJoinFailingFast = func(ctx context.Context, flow ExecutionFlow) error {
 		case <-ctx.Done():
 			return ctx.Err()
 		case err := <-errChan:
-			// If any of the goroutines returns an error, the
+			// If any of the layers returns an error, the
 			// entire flow stops immediately.
 			if err != nil {
 				return err
@@ -47,101 +100,146 @@ var ForkJoinFailingFast = func(ctx context.Context, flow ExecutionFlow) error {
 			// If err is nil, mark 1 layer as complete until all
 			// layer completes successfully.
 			done = done + 1
-			if done == len(flow.Executors) {
+			if done == layerCount {
 				return nil
 			}
 		}
 	}
 }
 
-var doForkJoinFailingFast = func(ctx context.Context, flow ExecutionFlow, currentLayerIdx int) error {
+// eagerLoader is implemented by executors whose loading tasks get started by the flow as goroutines
+// of their own, ahead of the other goroutines of their layer. Schedulers with limited concurrency
+// then never run a goroutine waiting for a loading task that is still queued behind it.
+type eagerLoader interface {
+	loadingTasks() []async.SilentTask
+}
+
+// doForkJoinFailingFast starts the executors in the given layer without blocking. The outcome of this
+// layer will be sent to errChan exactly once, either the first error or nil after all executors complete.
+var doForkJoinFailingFast = func(ctx context.Context, run *flowRun, currentLayerIdx int, errChan chan<- error) {
+	flow := run.flow
 	executors := flow.Executors[currentLayerIdx]
 
+	ctx, span := run.startLayerSpan(ctx, currentLayerIdx)
+
 	var errOnce sync.Once
-	errChan := make(chan error, 1)
+	report := func(err error) {
+		errOnce.Do(
+			func() {
+				errChan <- err
+				endSpan(span, err)
+			},
+		)
+	}
 
-	var wg sync.WaitGroup
+	fail := func(err error) {
+		// Release the main thread first before cancelling tasks
+		report(err)
 
-	// Execute loading + async components asynchronously
+		run.recordCancellation(currentLayerIdx, err)
+		cancelTasks(flow, currentLayerIdx, err)
+	}
+
+	// 1 goroutine for each eager loading task, each loading + async component & 1 for all sync components
+	pending := int32(1)
 	for _, executor := range executors {
-		if !executor.canBeInvokedAsync() {
-			continue
+		if l, ok := executor.(eagerLoader); ok {
+			pending = pending + int32(len(l.loadingTasks()))
 		}
 
-		wg.Add(1)
+		if executor.canBeInvokedAsync() {
+			pending = pending + 1
+		}
+	}
+
+	// The last goroutine to return reports the completion of this layer
+	complete := func() {
+		if atomic.AddInt32(&pending, -1) == 0 {
+			report(nil)
+		}
+	}
 
-		e := executor
-		go func() {
-			defer wg.Done()
+	// Start eager loading tasks first, their errors will be handled by the components
+	for idx, executor := range executors {
+		l, ok := executor.(eagerLoader)
+		if !ok {
+			continue
+		}
 
-			err := e.invokeAsyncTask(ctx)
+		ectx := run.withInvocationScope(ctx, currentLayerIdx, idx)
 
-			// When err is async.ErrCancelled, it means this task is
-			// being actively cancelled by the sync goroutine. We can
-			// swallow this error and let the other goroutine return
-			// an error to the caller.
-			if err == nil || strings.Contains(err.Error(), "task cancelled with reason") {
-				return
-			}
+		for _, task := range l.loadingTasks() {
+			t := task
 
-			errOnce.Do(
+			if err := run.spawn(
 				func() {
-					// Release the main thread first before cancelling tasks
-					errChan <- err
-				},
-			)
+					defer complete()
 
-			cancelTasks(flow, currentLayerIdx, err)
-		}()
+					t.ExecuteSync(ectx)
+				},
+			); err != nil {
+				fail(err)
+				complete()
+			}
+		}
 	}
 
-	wg.Add(1)
+	// Execute loading + async components asynchronously
+	for idx, executor := range executors {
+		if !executor.canBeInvokedAsync() {
+			continue
+		}
 
-	// Execute sync components sequentially
-	go func() {
-		defer wg.Done()
+		e, i := executor, idx
+		ectx := run.withInvocationScope(ctx, currentLayerIdx, idx)
+
+		if err := run.spawn(
+			func() {
+				defer complete()
+
+				err := e.invokeAsyncTask(ectx)
 
-		for _, executor := range executors {
-			// Block & wait for error before executing the next component
-			if err := executor.invokeSyncTask(ctx); err != nil {
 				// When err is async.ErrCancelled, it means this task is
-				// being actively cancelled by the async goroutine. We
-				// must stop execution and let the other goroutine return
+				// being actively cancelled by the sync goroutine. We can
+				// swallow this error and let the other goroutine return
 				// an error to the caller.
-				if strings.Contains(err.Error(), "task cancelled with reason") {
-					break
+				if err == nil || isCancellation(err) {
+					return
 				}
 
-				errOnce.Do(
-					func() {
-						// Release the main thread first before cancelling tasks
-						errChan <- err
-					},
-				)
-
-				cancelTasks(flow, currentLayerIdx, err)
+				fail(executorError(e, currentLayerIdx, i, err))
+			},
+		); err != nil {
+			fail(err)
+			complete()
+		}
+	}
 
-				return
+	// Execute sync components sequentially
+	if err := run.spawn(
+		func() {
+			defer complete()
+
+			for idx, executor := range executors {
+				// Block & wait for error before executing the next component
+				if err := executor.invokeSyncTask(run.withInvocationScope(ctx, currentLayerIdx, idx)); err != nil {
+					// When err is async.ErrCancelled, it means this task is
+					// being actively cancelled by the async goroutine. We
+					// must stop execution and let the other goroutine return
+					// an error to the caller.
+					if isCancellation(err) {
+						break
+					}
+
+					fail(executorError(executor, currentLayerIdx, idx, err))
+
+					return
+				}
 			}
-		}
-	}()
-
-	// Wait & close when ALL goroutines have returned.
-	done := make(chan struct{})
-	go func() {
-		wg.Wait()
-		close(done)
-	}()
-
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case err := <-errChan:
-		// If any of the goroutines returns an error, the
-		// entire flow stops immediately.
-		return err
-	case <-done:
-		return nil
+		},
+	); err != nil {
+		fail(err)
+		complete()
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -58,7 +59,7 @@ func TestForkJoinFailingFast(t *testing.T) {
 					},
 				)
 
-				assert.Equal(t, assert.AnError, actual)
+				assert.Equal(t, &ExecutorError{Executor: "executor 0-0", Err: assert.AnError}, actual)
 			},
 		},
 		{
@@ -77,7 +78,7 @@ func TestForkJoinFailingFast(t *testing.T) {
 					},
 				)
 
-				assert.Equal(t, assert.AnError, actual)
+				assert.Equal(t, &ExecutorError{Executor: "executor 0-0", Err: assert.AnError}, actual)
 			},
 		},
 		{
@@ -249,7 +250,7 @@ func TestForkJoinFailingFast(t *testing.T) {
 					},
 				)
 
-				assert.Equal(t, "error from sync task", actual.Error())
+				assert.Equal(t, `executor "executor 0-1" failed: error from sync task`, actual.Error())
 				assert.Equal(t, 2, val, "Val must carry value assigned by the 2nd mock right before returning an error")
 				assert.True(t, isCancelTasksCalled)
 			},
@@ -313,11 +314,106 @@ func TestForkJoinFailingFast(t *testing.T) {
 					},
 				)
 
-				assert.Equal(t, "error from async task", actual.Error())
+				assert.Equal(t, `executor "executor 0-0" failed: error from async task`, actual.Error())
 				assert.True(t, isCancelTasksCalled)
 				assert.Equal(t, context.Canceled, groupCtx.Err(), "when one task fails, the context sent into each task should have been cancelled")
 			},
 		},
+		{
+			desc: "flow executes on the given worker pool",
+			test: func(t *testing.T) {
+				pool := NewWorkerPool(WithMaxWorkers(3), WithMaxQueueSize(0))
+
+				tp1 := Executor[int]{
+					executingAsyncTask: async.Completed(1, nil),
+				}
+
+				tp2 := ExecutorWithLoading[int, int]{
+					loadingTask:       async.Completed(2, nil),
+					executingSyncTask: async.Completed(2, nil),
+				}
+
+				flow := NewExecutionFlowBuilder().
+					Append(tp1, tp2).
+					WithWorkerPool(pool).
+					Get()
+
+				actual := ForkJoinFailingFast(context.Background(), flow)
+
+				assert.Nil(t, actual)
+				assert.Equal(t, uint64(3), pool.Stats().SubmittedJobs, "1 job for each async/loading executor & 1 for the sync lane")
+			},
+		},
+		{
+			desc: "saturated worker pool fails the flow",
+			test: func(t *testing.T) {
+				pool := NewWorkerPool(WithMaxWorkers(1), WithMaxQueueSize(0))
+
+				release := make(chan struct{})
+				defer close(release)
+
+				assert.Nil(t, pool.Go(context.Background(), func() { <-release }))
+
+				var isExecuted bool
+				tp1 := Executor[int]{
+					executingSyncTask: async.NewTask(
+						func(ctx context.Context) (int, error) {
+							isExecuted = true
+							return 1, nil
+						},
+					),
+				}
+
+				flow := NewExecutionFlowBuilder().
+					Append(tp1).
+					WithWorkerPool(pool).
+					Get()
+
+				actual := ForkJoinFailingFast(context.Background(), flow)
+
+				assert.Equal(t, ErrWorkerPoolSaturated, actual)
+				assert.False(t, isExecuted)
+			},
+		},
+		{
+			desc: "flow with max concurrency runs at most that many goroutines at once",
+			test: func(t *testing.T) {
+				var running, maxRunning int32
+
+				newExecutor := func() Executor[int] {
+					return Executor[int]{
+						executingAsyncTask: async.NewTask(
+							func(ctx context.Context) (int, error) {
+								current := atomic.AddInt32(&running, 1)
+								defer atomic.AddInt32(&running, -1)
+
+								for {
+									max := atomic.LoadInt32(&maxRunning)
+									if current <= max || atomic.CompareAndSwapInt32(&maxRunning, max, current) {
+										break
+									}
+								}
+
+								<-time.After(10 * time.Millisecond)
+								return 1, nil
+							},
+						),
+					}
+				}
+
+				flow := NewExecutionFlowBuilder().
+					Append(newExecutor(), newExecutor(), newExecutor()).
+					NextLayer().
+					Append(newExecutor(), newExecutor()).
+					WithMaxConcurrency(2).
+					Get()
+
+				actual := ForkJoinFailingFast(context.Background(), flow)
+
+				assert.Nil(t, actual)
+				assert.Equal(t, int32(2), atomic.LoadInt32(&maxRunning))
+			},
+		},
 	}
 
 	for _, scenario := range scenarios {
@@ -0,0 +1,186 @@
+package component
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jamestrandung/go-concurrency/v2/async"
+)
+
+// Future represents the result of an executor that will become available
+// once the executor completes. Components depending on the outputs of
+// other components should read them via the futures returned together
+// with the executors of these components.
+//
+// Reading a future whose executor failed or got cancelled returns an
+// UpstreamError so that consumers can either fail cleanly or fall back
+// deliberately, e.g. via GetOrDefault, instead of computing with zero
+// values.
+type Future[T any] struct {
+	state *futureState[T]
+}
+
+type futureState[T any] struct {
+	configs *executorConfigs
+	task    async.Task[T]
+	// derived is true for futures derived from other futures, whose
+	// task is not run by a flow but when the result is first needed.
+	derived   bool
+	startOnce sync.Once
+}
+
+func newFuture[T any](configs *executorConfigs, task async.Task[T]) Future[T] {
+	return Future[T]{
+		state: &futureState[T]{
+			configs: configs,
+			task:    task,
+		},
+	}
+}
+
+// UpstreamError is returned when reading the result of an executor
+// that failed or got cancelled. Err is the error of this executor.
+type UpstreamError struct {
+	Producer string
+	Err      error
+}
+
+func (e *UpstreamError) Error() string {
+	return fmt.Sprintf("upstream executor %q failed: %v", e.Producer, e.Err)
+}
+
+func (e *UpstreamError) Unwrap() error {
+	return e.Err
+}
+
+// Cancelled returns whether the executor got cancelled instead of failing on its own.
+func (e *UpstreamError) Cancelled() bool {
+	return isCancellation(e.Err)
+}
+
+// upstreamError wraps the given error of the executor this future belongs to.
+// Errors of derived futures come from the futures they are derived from and
+// are already wrapped.
+func (f Future[T]) upstreamError(err error) error {
+	if err == nil || f.state.derived {
+		return err
+	}
+
+	producer := ""
+	if f.state.configs != nil {
+		producer = f.state.configs.name
+	}
+
+	return &UpstreamError{
+		Producer: producer,
+		Err:      err,
+	}
+}
+
+// Get blocks until this future is resolved and returns the result of the
+// executor it belongs to, or an UpstreamError if this executor failed or got
+// cancelled. When ctx is the context given to a component by an execution
+// flow, the time spent blocked is recorded like in Await.
+//
+// If ctx is done before this future is resolved, Get gives up waiting and
+// returns an error wrapping both ErrWaitAbandoned & the cause of ctx. The
+// wait also ends when the executor gets cancelled by ExecutionFlow.Cancel.
+func (f Future[T]) Get(ctx context.Context) (T, error) {
+	f.start(ctx)
+
+	result, err := await(ctx, f.state.task, f.Done)
+	if !f.IsResolved() {
+		// The wait was abandoned
+		return result, err
+	}
+
+	result, err = outcomeOf(f.state.task)
+
+	return result, f.upstreamError(err)
+}
+
+// GetWithTimeout works like Get but gives up waiting after the given timeout.
+func (f Future[T]) GetWithTimeout(ctx context.Context, timeout time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return f.Get(ctx)
+}
+
+// GetOrDefault works like Get but returns the given default result if the
+// executor this future belongs to returned an error or if the wait was
+// abandoned.
+func (f Future[T]) GetOrDefault(ctx context.Context, defaultResult T) T {
+	result, err := f.Get(ctx)
+	if err != nil {
+		return defaultResult
+	}
+
+	return result
+}
+
+// Err returns an UpstreamError wrapping the error returned by the executor
+// this future belongs to if this future is resolved. It returns nil if the
+// executor succeeded or has not completed yet.
+func (f Future[T]) Err() error {
+	if !f.IsResolved() {
+		return nil
+	}
+
+	return f.upstreamError(errorOf(f.state.task))
+}
+
+// Done returns a channel that gets closed once this future is resolved.
+func (f Future[T]) Done() <-chan struct{} {
+	f.start(context.Background())
+
+	return doneOf(f.state.task)
+}
+
+// start runs the task of derived futures the first time their result is needed. The
+// task must not get cancelled if the first consumer abandons its wait, but it keeps
+// the values of ctx to attribute waits for the original futures to this consumer.
+func (f Future[T]) start(ctx context.Context) {
+	if !f.state.derived {
+		return
+	}
+
+	f.state.startOnce.Do(
+		func() {
+			f.state.task.Execute(context.WithoutCancel(ctx))
+		},
+	)
+}
+
+// IsResolved returns whether the executor this future belongs to has
+// completed or got cancelled, in which case Get does not block.
+func (f Future[T]) IsResolved() bool {
+	return isTerminated(f.state.task)
+}
+
+// Project blocks until the given future is resolved and returns the part of its
+// result extracted by the given function, or the error returned by Get. Futures
+// of components usually consist of projections giving access to each output of
+// a component.
+func Project[T any, R any](ctx context.Context, f Future[T], project func(T) R) (R, error) {
+	result, err := f.Get(ctx)
+	if err != nil {
+		var zero R
+		return zero, err
+	}
+
+	return project(result), nil
+}
+
+// ProjectOrDefault works like Project but returns the given default value if the
+// executor the given future belongs to returned an error or if the wait was abandoned.
+func ProjectOrDefault[T any, R any](ctx context.Context, f Future[T], project func(T) R, defaultValue R) R {
+	result, err := Project(ctx, f, project)
+	if err != nil {
+		return defaultValue
+	}
+
+	return result
+}
@@ -0,0 +1,208 @@
+package component
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jamestrandung/go-concurrency/v2/async"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFuture(t *testing.T) {
+	scenarios := []struct {
+		desc string
+		test func(t *testing.T)
+	}{
+		{
+			desc: "future is resolved once the executor completes",
+			test: func(t *testing.T) {
+				release := make(chan struct{})
+
+				e, future := CreateAsyncExecutor[int](
+					asyncComponentFunc[int](
+						func(ctx context.Context) (int, error) {
+							<-release
+							return 1, nil
+						},
+					),
+				)
+
+				assert.False(t, future.IsResolved())
+				assert.Nil(t, future.Err())
+
+				select {
+				case <-future.Done():
+					assert.Fail(t, "future must not be done before the executor completes")
+				default:
+				}
+
+				go e.InvokeExecutingTask(context.Background())
+				close(release)
+
+				select {
+				case <-future.Done():
+				case <-time.After(time.Second):
+					assert.Fail(t, "future is not done after the executor completed")
+				}
+
+				assert.True(t, future.IsResolved())
+				assert.Nil(t, future.Err())
+
+				actual, err := future.Get(context.Background())
+				assert.Equal(t, 1, actual)
+				assert.Nil(t, err)
+				assert.Equal(t, 1, future.GetOrDefault(context.Background(), 2))
+			},
+		},
+		{
+			desc: "reading a failed executor returns an UpstreamError",
+			test: func(t *testing.T) {
+				mockSyncComponent := &MockSyncComponent[int]{}
+				mockSyncComponent.On("ExecuteSync", mock.Anything).
+					Return(1, assert.AnError).
+					Once()
+
+				e, future := CreateSyncExecutor[int](mockSyncComponent, WithName("routing"))
+
+				assert.Equal(t, assert.AnError, e.InvokeExecutingTask(context.Background()))
+
+				<-future.Done()
+
+				expected := &UpstreamError{
+					Producer: "routing",
+					Err:      assert.AnError,
+				}
+
+				assert.Equal(t, expected, future.Err())
+
+				actual, err := future.Get(context.Background())
+				assert.Equal(t, 1, actual)
+				assert.Equal(t, expected, err)
+				assert.ErrorIs(t, err, assert.AnError)
+				assert.False(t, expected.Cancelled())
+				assert.Equal(t, `upstream executor "routing" failed: `+assert.AnError.Error(), err.Error())
+				assert.Equal(t, 2, future.GetOrDefault(context.Background(), 2))
+			},
+		},
+		{
+			desc: "future is resolved once the executor gets cancelled",
+			test: func(t *testing.T) {
+				e, future := CreateAsyncExecutor[int](&MockAsyncComponent[int]{})
+
+				done := future.Done()
+				e.cancel(assert.AnError)
+
+				<-done
+
+				assert.True(t, future.IsResolved())
+				assert.ErrorContains(t, future.Err(), assert.AnError.Error())
+
+				var upstreamErr *UpstreamError
+				assert.ErrorAs(t, future.Err(), &upstreamErr)
+				assert.True(t, upstreamErr.Cancelled())
+			},
+		},
+		{
+			desc: "wait is abandoned once ctx is done",
+			test: func(t *testing.T) {
+				_, future := CreateAsyncExecutor[int](&MockAsyncComponent[int]{})
+
+				ctx, cancel := context.WithCancelCause(context.Background())
+				cancel(assert.AnError)
+
+				actual, err := future.Get(ctx)
+				assert.Equal(t, 0, actual)
+				assert.ErrorIs(t, err, ErrWaitAbandoned)
+				assert.ErrorIs(t, err, assert.AnError)
+				assert.Equal(t, 2, future.GetOrDefault(ctx, 2))
+
+				actual, err = future.GetWithTimeout(context.Background(), time.Millisecond)
+				assert.Equal(t, 0, actual)
+				assert.ErrorIs(t, err, ErrWaitAbandoned)
+				assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+				assert.False(t, future.IsResolved())
+			},
+		},
+		{
+			desc: "wait ends once the flow cancels the executor",
+			test: func(t *testing.T) {
+				e, future := CreateAsyncExecutor[int](
+					asyncComponentFunc[int](
+						func(ctx context.Context) (int, error) {
+							<-ctx.Done()
+							return 0, ctx.Err()
+						},
+					),
+				)
+
+				flow := NewExecutionFlowBuilder().
+					Append(e).
+					Get()
+
+				go e.InvokeExecutingTask(context.Background())
+
+				assert.Eventually(t, func() bool {
+					return e.GetExecutingTask().State() == async.IsRunning
+				}, time.Second, time.Millisecond)
+
+				errChan := make(chan error, 1)
+				go func() {
+					_, err := future.Get(context.Background())
+					errChan <- err
+				}()
+
+				flow.Cancel(0, assert.AnError)
+
+				select {
+				case err := <-errChan:
+					assert.ErrorContains(t, err, assert.AnError.Error())
+				case <-time.After(time.Second):
+					assert.Fail(t, "wait did not end after the executor got cancelled")
+				}
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(sc.desc, sc.test)
+	}
+}
+
+func TestProject(t *testing.T) {
+	type output struct {
+		distanceInKM float64
+	}
+
+	distanceInKM := func(o output) float64 {
+		return o.distanceInKM
+	}
+
+	succeeding, succeedingFuture := CreateSyncOrchestratingExecutorWithResult(
+		func(ctx context.Context) (output, error) {
+			return output{distanceInKM: 1}, nil
+		},
+	)
+
+	failing, failingFuture := CreateSyncOrchestratingExecutorWithResult(
+		func(ctx context.Context) (output, error) {
+			return output{distanceInKM: 1}, assert.AnError
+		},
+	)
+
+	assert.Nil(t, succeeding.InvokeExecutingTask(context.Background()))
+	assert.Equal(t, assert.AnError, failing.InvokeExecutingTask(context.Background()))
+
+	actual, err := Project(context.Background(), succeedingFuture, distanceInKM)
+	assert.Equal(t, 1.0, actual)
+	assert.Nil(t, err)
+	assert.Equal(t, 1.0, ProjectOrDefault(context.Background(), succeedingFuture, distanceInKM, 2))
+
+	actual, err = Project(context.Background(), failingFuture, distanceInKM)
+	assert.Equal(t, 0.0, actual)
+	assert.Equal(t, &UpstreamError{Err: assert.AnError}, err)
+	assert.Equal(t, 2.0, ProjectOrDefault(context.Background(), failingFuture, distanceInKM, 2))
+}
@@ -0,0 +1,153 @@
+package component
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrUnexpectedResultType is returned when interceptors replace the result of a phase
+// with one whose type differs from the type the executor expects.
+var ErrUnexpectedResultType = errors.New("interceptors returned a result of unexpected type")
+
+// Phase represents a phase of an executor that can be intercepted.
+type Phase string
+
+// Various executor phases.
+const (
+	PhaseLoad         Phase = "load"          // PhaseLoad represents the loading task of a component with loading, loaders use load:<name>
+	PhaseExecuteSync  Phase = "execute_sync"  // PhaseExecuteSync represents the executing task running in the sync lane
+	PhaseExecuteAsync Phase = "execute_async" // PhaseExecuteAsync represents the executing task running asynchronously
+)
+
+// ExecutorKind represents the kind of component that an executor encapsulates.
+type ExecutorKind string
+
+// Various executor kinds.
+const (
+	KindAsync            ExecutorKind = "async"              // KindAsync represents executors of AsyncComponent
+	KindAsyncWithLoading ExecutorKind = "async_with_loading" // KindAsyncWithLoading represents executors of AsyncComponentWithLoading
+	KindSync             ExecutorKind = "sync"               // KindSync represents executors of SyncComponent
+	KindSyncWithLoading  ExecutorKind = "sync_with_loading"  // KindSyncWithLoading represents executors of SyncComponentWithLoading
+	KindCustom           ExecutorKind = "custom"             // KindCustom represents executors created from a CustomExecutor
+	KindBackground       ExecutorKind = "background"         // KindBackground represents executors created by CreateBackgroundExecutor
+)
+
+// isAsync returns whether executors of this kind execute their main logic outside the sync lane.
+func (k ExecutorKind) isAsync() bool {
+	return k == KindAsync || k == KindAsyncWithLoading || k == KindBackground
+}
+
+// hasLoading returns whether executors of this kind have a loading task.
+func (k ExecutorKind) hasLoading() bool {
+	return k == KindSyncWithLoading || k == KindAsyncWithLoading
+}
+
+// Invocation describes one phase of an executor being invoked by an execution flow.
+type Invocation struct {
+	Executor    IExecutor
+	Kind        ExecutorKind
+	Phase       Phase
+	LayerIdx    int
+	ExecutorIdx int
+}
+
+// Handler carries out one phase of an executor & returns its result.
+type Handler func(ctx context.Context) (any, error)
+
+// Interceptor runs around one phase of an executor. It must call next to carry
+// out the phase, usually with the given ctx, and return a result & an error,
+// typically the ones returned by next.
+type Interceptor func(ctx context.Context, inv Invocation, next Handler) (any, error)
+
+var (
+	globalInterceptorsLock sync.RWMutex
+	globalInterceptors     []Interceptor
+)
+
+// RegisterGlobalInterceptors registers the given interceptors to run around the
+// executors of all execution flows. Global interceptors run before the ones
+// registered on a particular flow.
+func RegisterGlobalInterceptors(interceptors ...Interceptor) {
+	globalInterceptorsLock.Lock()
+	defer globalInterceptorsLock.Unlock()
+
+	globalInterceptors = append(globalInterceptors, interceptors...)
+}
+
+// ClearGlobalInterceptors removes all interceptors registered globally.
+func ClearGlobalInterceptors() {
+	globalInterceptorsLock.Lock()
+	defer globalInterceptorsLock.Unlock()
+
+	globalInterceptors = nil
+}
+
+func getGlobalInterceptors() []Interceptor {
+	globalInterceptorsLock.RLock()
+	defer globalInterceptorsLock.RUnlock()
+
+	return globalInterceptors
+}
+
+// chainInterceptors returns a Handler running the given interceptors
+// in order around the final handler.
+func chainInterceptors(interceptors []Interceptor, inv Invocation, final Handler) Handler {
+	h := final
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor := interceptors[i]
+		next := h
+
+		h = func(ctx context.Context) (any, error) {
+			return interceptor(ctx, inv, next)
+		}
+	}
+
+	return h
+}
+
+// intercept carries out the given phase of the executor being invoked in ctx
+// via the interceptors of its flow. Outside of a flow, fn is called directly.
+func intercept[T any](ctx context.Context, phase Phase, fn func(context.Context) (T, error)) (T, error) {
+	ctx = withPhase(ctx, phase)
+
+	s, ok := invocationScopeFrom(ctx)
+	if !ok {
+		return fn(ctx)
+	}
+
+	if !s.run.enterPhase() {
+		var zero T
+		return zero, cancelReason(s.run.cancelCause(ctx))
+	}
+
+	defer s.run.leavePhase()
+
+	if len(s.run.interceptors) == 0 {
+		return fn(ctx)
+	}
+
+	h := chainInterceptors(
+		s.run.interceptors,
+		s.invocation(phase),
+		func(ctx context.Context) (any, error) {
+			return fn(ctx)
+		},
+	)
+
+	r, err := h(ctx)
+
+	if r == nil {
+		var temp T
+		return temp, err
+	}
+
+	result, ok := r.(T)
+	if !ok {
+		var temp T
+		return temp, fmt.Errorf("%w: phase %s expects %T, got %T", ErrUnexpectedResultType, phase, temp, r)
+	}
+
+	return result, err
+}
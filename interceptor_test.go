@@ -0,0 +1,180 @@
+package component
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestInterceptors(t *testing.T) {
+	defer ClearGlobalInterceptors()
+
+	scenarios := []struct {
+		desc string
+		test func(t *testing.T)
+	}{
+		{
+			desc: "interceptors run around every phase of every executor",
+			test: func(t *testing.T) {
+				var mu sync.Mutex
+				var invocations []string
+
+				record := func(ctx context.Context, inv Invocation, next Handler) (any, error) {
+					r, err := next(ctx)
+
+					mu.Lock()
+					defer mu.Unlock()
+
+					invocations = append(invocations, fmt.Sprintf("%d-%d %s %s %v %v", inv.LayerIdx, inv.ExecutorIdx, inv.Kind, inv.Phase, r, err))
+
+					return r, err
+				}
+
+				mockAsyncComponent := &MockAsyncComponent[int]{}
+				mockAsyncComponent.On("Execute", mock.Anything).Return(1, nil).Once()
+
+				mockSyncComponent := &MockSyncComponent[int]{}
+				mockSyncComponent.On("ExecuteSync", mock.Anything).Return(2, nil).Once()
+
+				mockSyncComponentWithLoading := &MockSyncComponentWithLoading[int, int]{}
+				mockSyncComponentWithLoading.On("Load", mock.Anything).Return(3, nil).Once()
+				mockSyncComponentWithLoading.On("ExecuteSync", mock.Anything, LoadData[int]{Data: 3}).Return(4, nil).Once()
+
+				flow := NewExecutionFlowBuilder().
+					Append(
+						executorOf(CreateAsyncExecutor[int](mockAsyncComponent)),
+						executorOf(CreateSyncExecutor[int](mockSyncComponent)),
+					).
+					NextLayer().
+					Append(executorOf(CreateSyncExecutorWithLoading[int, int](mockSyncComponentWithLoading))).
+					WithInterceptors(record).
+					Get()
+
+				actual := ForkJoinFailingFast(context.Background(), flow)
+				assert.Nil(t, actual)
+
+				sort.Strings(invocations)
+				assert.Equal(
+					t,
+					[]string{
+						"0-0 async execute_async 1 <nil>",
+						"0-1 sync execute_sync 2 <nil>",
+						"1-0 sync_with_loading execute_sync 4 <nil>",
+						"1-0 sync_with_loading load 3 <nil>",
+					},
+					invocations,
+				)
+			},
+		},
+		{
+			desc: "global interceptors run before flow interceptors",
+			test: func(t *testing.T) {
+				defer ClearGlobalInterceptors()
+
+				var order []string
+
+				newInterceptor := func(name string) Interceptor {
+					return func(ctx context.Context, inv Invocation, next Handler) (any, error) {
+						order = append(order, name+" before")
+						defer func() {
+							order = append(order, name+" after")
+						}()
+
+						return next(ctx)
+					}
+				}
+
+				RegisterGlobalInterceptors(newInterceptor("global"))
+
+				e := CreateSyncOrchestratingExecutor(func(ctx context.Context) error {
+					order = append(order, "component")
+					return nil
+				})
+
+				flow := NewExecutionFlowBuilder().
+					Append(e).
+					WithInterceptors(newInterceptor("flow")).
+					Get()
+
+				actual := ForkJoinFailingFast(context.Background(), flow)
+
+				assert.Nil(t, actual)
+				assert.Equal(t, []string{"global before", "flow before", "component", "flow after", "global after"}, order)
+			},
+		},
+		{
+			desc: "interceptors can short-circuit a phase",
+			test: func(t *testing.T) {
+				mockAsyncComponent := &MockAsyncComponent[int]{}
+
+				e, _ := CreateAsyncExecutor[int](mockAsyncComponent)
+
+				flow := NewExecutionFlowBuilder().
+					Append(e).
+					WithInterceptors(
+						func(ctx context.Context, inv Invocation, next Handler) (any, error) {
+							return nil, assert.AnError
+						},
+					).
+					Get()
+
+				actual := ForkJoinFailingFast(context.Background(), flow)
+
+				assert.Equal(t, &ExecutorError{Executor: "component.MockAsyncComponent[int]", Err: assert.AnError}, actual)
+				mockAsyncComponent.AssertNotCalled(t, "Execute", mock.Anything)
+			},
+		},
+		{
+			desc: "interceptors replacing the result with one of another type fail the executor",
+			test: func(t *testing.T) {
+				e, future := CreateSyncOrchestratingExecutorWithResult(func(ctx context.Context) (int, error) {
+					return 1, nil
+				})
+
+				flow := NewExecutionFlowBuilder().
+					Append(e).
+					WithInterceptors(
+						func(ctx context.Context, inv Invocation, next Handler) (any, error) {
+							_, err := next(ctx)
+							return "1", err
+						},
+					).
+					Get()
+
+				actual := ForkJoinFailingFast(context.Background(), flow)
+
+				assert.ErrorIs(t, actual, ErrUnexpectedResultType)
+				assert.ErrorIs(t, future.Err(), ErrUnexpectedResultType)
+			},
+		},
+		{
+			desc: "interceptors are not applied outside of a flow",
+			test: func(t *testing.T) {
+				defer ClearGlobalInterceptors()
+
+				RegisterGlobalInterceptors(
+					func(ctx context.Context, inv Invocation, next Handler) (any, error) {
+						return nil, assert.AnError
+					},
+				)
+
+				e, future := CreateSyncOrchestratingExecutorWithResult(func(ctx context.Context) (int, error) {
+					return 1, nil
+				})
+
+				assert.Nil(t, e.InvokeExecutingTask(context.Background()))
+				assert.Equal(t, 1, future.GetOrDefault(context.Background(), 0))
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(sc.desc, sc.test)
+	}
+}
@@ -0,0 +1,277 @@
+package component
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jamestrandung/go-concurrency/v2/async"
+)
+
+// loaderPhasePrefix prefixes the phases of loaders, e.g. load:configs.
+const loaderPhasePrefix = string(PhaseLoad) + ":"
+
+// SyncComponentWithLoaders represents those components that must be executed
+// sequentially together with other SyncComponent, like SyncComponentWithLoading,
+// but need to load several pieces of data from different sources beforehand.
+//
+// Each Loader returned by Loaders runs concurrently in its own task, with its
+// own error, timeout & timing. ExecuteSync gets called once all of them have
+// completed and reads their results via Loader.Data.
+type SyncComponentWithLoaders[T any] interface {
+	Loaders() []ILoader
+	ExecuteSync(ctx context.Context) (T, error)
+}
+
+// ILoader represents the loaders that a SyncComponentWithLoaders can declare.
+type ILoader interface {
+	Name() string
+	phase() Phase
+	task() async.SilentTask
+}
+
+type loaderConfigs struct {
+	timeout           time.Duration
+	singleFlightGroup *SingleFlightGroup
+	singleFlightKey   string
+}
+
+// LoaderOption customizes a Loader when it gets created.
+type LoaderOption func(*loaderConfigs)
+
+// WithLoaderTimeout bounds the time the loader may take, like WithLoadTimeout
+// does for the loading task of an executor.
+func WithLoaderTimeout(timeout time.Duration) LoaderOption {
+	return func(configs *loaderConfigs) {
+		configs.timeout = timeout
+	}
+}
+
+// WithLoaderSingleFlight makes the loader share its in-flight call with all other
+// loaders & executors using the same group & key, like WithSingleFlight does for
+// executors.
+func WithLoaderSingleFlight(group *SingleFlightGroup, key string) LoaderOption {
+	return func(configs *loaderConfigs) {
+		configs.singleFlightGroup = group
+		configs.singleFlightKey = key
+	}
+}
+
+// Loader loads one piece of data needed by a SyncComponentWithLoaders. A Loader belongs
+// to exactly 1 component & its name must be unique within this component, which gets
+// checked by ExecutionFlowBuilder.Build.
+type Loader[V any] struct {
+	name        string
+	loadingTask async.Task[V]
+}
+
+// NewLoader returns a Loader loading data using the given function.
+func NewLoader[V any](name string, load func(ctx context.Context) (V, error), options ...LoaderOption) *Loader[V] {
+	configs := &loaderConfigs{}
+	for _, o := range options {
+		o(configs)
+	}
+
+	l := &Loader[V]{
+		name: name,
+	}
+
+	l.loadingTask = async.NewTask[V](
+		func(ctx context.Context) (V, error) {
+			return intercept(
+				ctx,
+				l.phase(),
+				withTimeout(
+					configs.timeout, func(ctx context.Context) (V, error) {
+						return executeInSingleFlight(ctx, configs.singleFlightGroup, configs.singleFlightKey, load)
+					},
+				),
+			)
+		},
+	)
+
+	return l
+}
+
+// Name returns the name identifying this loader.
+func (l *Loader[V]) Name() string {
+	return l.name
+}
+
+// Data blocks until this loader completes and returns the data and/or the error
+// it returned. SyncComponentWithLoaders is responsible for handling this error.
+func (l *Loader[V]) Data(ctx context.Context) LoadData[V] {
+	data, err := Await(ctx, l.loadingTask)
+
+	return LoadData[V]{
+		Data: data,
+		Err:  err,
+	}
+}
+
+func (l *Loader[V]) phase() Phase {
+	return Phase(loaderPhasePrefix + l.name)
+}
+
+func (l *Loader[V]) task() async.SilentTask {
+	return l.loadingTask
+}
+
+// isLoaderPhase returns whether the given phase belongs to a Loader.
+func isLoaderPhase(phase Phase) bool {
+	return strings.HasPrefix(string(phase), loaderPhasePrefix)
+}
+
+// loaderPhasesOf returns the phases of the loaders of the given executor, sorted by name.
+func loaderPhasesOf(e IExecutor) []Phase {
+	var result []Phase
+	for phase := range e.phaseTasks() {
+		if isLoaderPhase(phase) {
+			result = append(result, phase)
+		}
+	}
+
+	sort.Slice(
+		result, func(i, j int) bool {
+			return result[i] < result[j]
+		},
+	)
+
+	return result
+}
+
+// loadersDeclarer is implemented by the executors of SyncComponentWithLoaders.
+type loadersDeclarer interface {
+	declaredLoaders() []ILoader
+}
+
+// validateLoaders returns the mistakes found in the loaders of the executor at the given position.
+func validateLoaders(loaders []ILoader, layerIdx int, executorIdx int) []error {
+	var errs []error
+
+	names := make(map[string]struct{}, len(loaders))
+	for _, l := range loaders {
+		if _, ok := names[l.Name()]; ok {
+			errs = append(
+				errs,
+				fmt.Errorf("%w: executor %d in layer %d has several loaders named %q", ErrDuplicateLoaderName, executorIdx, layerIdx, l.Name()),
+			)
+
+			continue
+		}
+
+		names[l.Name()] = struct{}{}
+	}
+
+	return errs
+}
+
+// ExecutorWithLoaders encapsulates the tasks that need to be executed to carry
+// out the business logic of a SyncComponentWithLoaders.
+type ExecutorWithLoaders[T any] struct {
+	executorMetadata
+	loaders           []ILoader
+	executingSyncTask async.Task[T]
+}
+
+// CreateSyncExecutorWithLoaders returns an ExecutorWithLoaders encapsulating the
+// loading tasks of the loaders declared by the given component & its executing
+// task, together with the Future of its result.
+func CreateSyncExecutorWithLoaders[T any](c SyncComponentWithLoaders[T], options ...ExecutorOption) (ExecutorWithLoaders[T], Future[T]) {
+	configs := newExecutorConfigs(c, options)
+	loaders := c.Loaders()
+
+	executingSyncTask := async.NewTask[T](
+		func(ctx context.Context) (T, error) {
+			// Flows start the loaders on their own, see loadingTasks
+			if _, ok := invocationScopeFrom(ctx); !ok {
+				for _, l := range loaders {
+					l.task().Execute(ctx)
+				}
+			}
+
+			// Block & wait for all loaders, their errors
+			// will be handled by the component itself
+			waitCtx := withPhase(ctx, PhaseExecuteSync)
+			for _, l := range loaders {
+				if err := awaitTermination(waitCtx, l.task()); err != nil {
+					break
+				}
+			}
+
+			return intercept(ctx, PhaseExecuteSync, withTimeout(configs.executeTimeout, c.ExecuteSync))
+		},
+	)
+
+	return ExecutorWithLoaders[T]{
+		executorMetadata:  executorMetadata{configs},
+		loaders:           loaders,
+		executingSyncTask: executingSyncTask,
+	}, newFuture(configs, executingSyncTask)
+}
+
+func (e ExecutorWithLoaders[T]) invokeSyncTask(ctx context.Context) error {
+	if e.executingSyncTask != nil {
+		return errorOf(e.executingSyncTask.ExecuteSync(ctx))
+	}
+
+	return nil
+}
+
+func (e ExecutorWithLoaders[T]) declaredLoaders() []ILoader {
+	return e.loaders
+}
+
+// loadingTasks returns the tasks of the loaders, which the flow starts ahead of the executing task.
+func (e ExecutorWithLoaders[T]) loadingTasks() []async.SilentTask {
+	result := make([]async.SilentTask, 0, len(e.loaders))
+	for _, l := range e.loaders {
+		result = append(result, l.task())
+	}
+
+	return result
+}
+
+func (e ExecutorWithLoaders[T]) canBeInvokedAsync() bool {
+	return false
+}
+
+func (e ExecutorWithLoaders[T]) invokeAsyncTask(ctx context.Context) error {
+	return nil
+}
+
+func (e ExecutorWithLoaders[T]) cancel(err error) {
+	for _, l := range e.loaders {
+		l.task().CancelWithReason(err)
+	}
+
+	if e.executingSyncTask != nil {
+		e.executingSyncTask.CancelWithReason(err)
+	}
+}
+
+func (e ExecutorWithLoaders[T]) kind() ExecutorKind {
+	return KindSyncWithLoading
+}
+
+func (e ExecutorWithLoaders[T]) phaseTasks() map[Phase]any {
+	result := map[Phase]any{
+		PhaseExecuteSync: e.executingSyncTask,
+	}
+
+	for _, l := range e.loaders {
+		result[l.phase()] = l.task()
+	}
+
+	return result
+}
+
+func (e ExecutorWithLoaders[T]) InvokeExecutingTask(ctx context.Context) error {
+	return errorOf(e.GetExecutingTask().ExecuteSync(ctx))
+}
+
+func (e ExecutorWithLoaders[T]) GetExecutingTask() async.Task[T] {
+	return e.executingSyncTask
+}
@@ -0,0 +1,199 @@
+package component
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type componentWithLoaders struct {
+	configs *Loader[int]
+	flags   *Loader[string]
+	profile *Loader[bool]
+	execute func(ctx context.Context, c componentWithLoaders) (string, error)
+}
+
+func (c componentWithLoaders) Loaders() []ILoader {
+	return []ILoader{c.configs, c.flags, c.profile}
+}
+
+func (c componentWithLoaders) ExecuteSync(ctx context.Context) (string, error) {
+	return c.execute(ctx, c)
+}
+
+func TestCreateSyncExecutorWithLoaders(t *testing.T) {
+	newComponent := func() componentWithLoaders {
+		return componentWithLoaders{
+			configs: NewLoader(
+				"configs", func(ctx context.Context) (int, error) {
+					<-time.After(10 * time.Millisecond)
+					return 1, nil
+				},
+			),
+			flags: NewLoader(
+				"flags", func(ctx context.Context) (string, error) {
+					return "", assert.AnError
+				},
+			),
+			profile: NewLoader(
+				"profile", func(ctx context.Context) (bool, error) {
+					<-ctx.Done()
+					return false, ctx.Err()
+				},
+				WithLoaderTimeout(10*time.Millisecond),
+			),
+			execute: func(ctx context.Context, c componentWithLoaders) (string, error) {
+				assert.Equal(t, LoadData[int]{Data: 1}, c.configs.Data(ctx))
+				assert.Equal(t, LoadData[string]{Err: assert.AnError}, c.flags.Data(ctx))
+				assert.Equal(t, LoadData[bool]{Err: context.DeadlineExceeded}, c.profile.Data(ctx))
+
+				return "done", nil
+			},
+		}
+	}
+
+	scenarios := []struct {
+		desc string
+		test func(t *testing.T)
+	}{
+		{
+			desc: "loaders run concurrently before the executing task",
+			test: func(t *testing.T) {
+				e, future := CreateSyncExecutorWithLoaders[string](newComponent())
+				assert.Equal(t, KindSyncWithLoading, e.kind())
+				assert.False(t, e.canBeInvokedAsync(), "loaders are started by the flow on their own")
+				assert.Len(t, e.loadingTasks(), 3)
+
+				// Outside of a flow, the executing task starts the loaders itself
+				assert.Nil(t, e.invokeSyncTask(context.Background()))
+				assert.Equal(t, "done", future.GetOrDefault(context.Background(), ""))
+			},
+		},
+		{
+			desc: "each loader is reported separately",
+			test: func(t *testing.T) {
+				e, _ := CreateSyncExecutorWithLoaders[string](newComponent(), WithName("fare"))
+
+				flow := NewExecutionFlowBuilder().
+					Append(e).
+					Get()
+
+				report, err := ForkJoinFailingFastWithReport(context.Background(), flow)
+				assert.Nil(t, err)
+
+				fareReport := report.Executors[0]
+				assert.Equal(t, StatusSucceeded, fareReport.Status)
+				assert.Equal(t, StatusSucceeded, fareReport.Execute.Status)
+
+				if assert.Len(t, fareReport.Loads, 3) {
+					assert.Equal(t, Phase("load:configs"), fareReport.Loads[0].Phase)
+					assert.Equal(t, StatusSucceeded, fareReport.Loads[0].Status)
+					assert.Equal(t, Phase("load:flags"), fareReport.Loads[1].Phase)
+					assert.Equal(t, StatusFailed, fareReport.Loads[1].Status)
+					assert.Equal(t, assert.AnError, fareReport.Loads[1].Err)
+					assert.Equal(t, Phase("load:profile"), fareReport.Loads[2].Phase)
+					assert.Equal(t, StatusTimedOut, fareReport.Loads[2].Status)
+				}
+
+				producerPhases := make(map[Phase]bool)
+				for _, w := range fareReport.Waits {
+					assert.Equal(t, PhaseExecuteSync, w.Phase)
+					producerPhases[w.ProducerPhase] = true
+				}
+
+				assert.True(t, producerPhases["load:configs"], "time waiting for loaders is attributed to them")
+
+				assert.NotEmpty(t, report.CriticalPath())
+			},
+		},
+		{
+			desc: "each loader is started through the scheduler",
+			test: func(t *testing.T) {
+				mockScheduler := NewMockScheduler(t)
+				mockScheduler.On("Go", mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						go args.Get(1).(func())()
+					}).
+					Return(nil).
+					Times(4)
+
+				e, future := CreateSyncExecutorWithLoaders[string](newComponent())
+
+				flow := NewExecutionFlowBuilder().
+					Append(e).
+					WithScheduler(mockScheduler).
+					Get()
+
+				assert.Nil(t, ForkJoinFailingFast(context.Background(), flow))
+				assert.Equal(t, "done", future.GetOrDefault(context.Background(), ""))
+			},
+		},
+		{
+			desc: "loaders run before the executing task on the inline scheduler",
+			test: func(t *testing.T) {
+				e, future := CreateSyncExecutorWithLoaders[string](newComponent())
+
+				flow := NewExecutionFlowBuilder().
+					Append(e).
+					WithScheduler(InlineScheduler{}).
+					Get()
+
+				assert.Nil(t, ForkJoinFailingFast(context.Background(), flow))
+				assert.Equal(t, "done", future.GetOrDefault(context.Background(), ""))
+			},
+		},
+		{
+			desc: "loaders can share their in-flight calls",
+			test: func(t *testing.T) {
+				group := NewSingleFlightGroup()
+				release := make(chan struct{})
+
+				calls := 0
+				newLoader := func() *Loader[int] {
+					return NewLoader(
+						"configs", func(ctx context.Context) (int, error) {
+							calls++
+							<-release
+
+							return 1, nil
+						},
+						WithLoaderSingleFlight(group, "configs"),
+					)
+				}
+
+				waiters := func() int {
+					group.mu.Lock()
+					defer group.mu.Unlock()
+
+					if f, ok := group.flights["configs"]; ok {
+						return f.waiters
+					}
+
+					return 0
+				}
+
+				first, second := newLoader(), newLoader()
+
+				first.task().Execute(context.Background())
+				assert.Eventually(t, func() bool { return waiters() == 1 }, time.Second, time.Millisecond)
+
+				second.task().Execute(context.Background())
+				assert.Eventually(t, func() bool { return waiters() == 2 }, time.Second, time.Millisecond)
+
+				close(release)
+
+				assert.Equal(t, LoadData[int]{Data: 1}, first.Data(context.Background()))
+				assert.Equal(t, LoadData[int]{Data: 1}, second.Data(context.Background()))
+				assert.Equal(t, 1, calls)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(sc.desc, sc.test)
+	}
+}
@@ -0,0 +1,183 @@
+package component
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"time"
+)
+
+// Keys of the attributes attached to loggers injected into the context of components
+// & to the records logged by flows.
+const (
+	LogKeyFlowID      = "flow_id"
+	LogKeyFlow        = "flow"
+	LogKeyExecutor    = "executor"
+	LogKeyLayerIdx    = "layer"
+	LogKeyExecutorIdx = "executor_idx"
+	LogKeyPhase       = "phase"
+	LogKeyFinallyIdx  = "finally_idx"
+)
+
+type loggingConfigs struct {
+	startLevel     slog.Level
+	finishLevel    slog.Level
+	errorLevel     slog.Level
+	cancelledLevel slog.Level
+}
+
+// LoggingOption customizes how a flow logs the lifecycle events of its executors.
+type LoggingOption func(*loggingConfigs)
+
+// WithStartLogLevel sets the level at which flows & executor phases log that they started.
+func WithStartLogLevel(level slog.Level) LoggingOption {
+	return func(configs *loggingConfigs) {
+		configs.startLevel = level
+	}
+}
+
+// WithFinishLogLevel sets the level at which flows & executor phases log that they finished successfully.
+func WithFinishLogLevel(level slog.Level) LoggingOption {
+	return func(configs *loggingConfigs) {
+		configs.finishLevel = level
+	}
+}
+
+// WithErrorLogLevel sets the level at which flows & executor phases log that they returned an error.
+func WithErrorLogLevel(level slog.Level) LoggingOption {
+	return func(configs *loggingConfigs) {
+		configs.errorLevel = level
+	}
+}
+
+// WithCancelledLogLevel sets the level at which flows & executor phases log that they got cancelled or timed out.
+func WithCancelledLogLevel(level slog.Level) LoggingOption {
+	return func(configs *loggingConfigs) {
+		configs.cancelledLevel = level
+	}
+}
+
+// flowLogging holds the logger of a flow & the levels of its lifecycle events.
+type flowLogging struct {
+	logger *slog.Logger
+	*loggingConfigs
+}
+
+func newFlowLogging(logger *slog.Logger, options []LoggingOption) *flowLogging {
+	configs := &loggingConfigs{
+		startLevel:     slog.LevelDebug,
+		finishLevel:    slog.LevelDebug,
+		errorLevel:     slog.LevelError,
+		cancelledLevel: slog.LevelWarn,
+	}
+
+	for _, o := range options {
+		o(configs)
+	}
+
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &flowLogging{
+		logger:         logger,
+		loggingConfigs: configs,
+	}
+}
+
+type loggerKey struct{}
+
+// LoggerFrom returns the logger injected into the given context by an execution flow, which
+// is tagged with the ID & name of the flow as well as the name, position & phase of the executor
+// being executed. If the flow was not built with a logger, slog.Default() is returned.
+func LoggerFrom(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return logger
+	}
+
+	return slog.Default()
+}
+
+func withLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// startLogging injects the logger of this run into ctx & logs that the flow started.
+func (r *flowRun) startLogging(ctx context.Context) context.Context {
+	if r.flow.logging == nil {
+		return ctx
+	}
+
+	r.logger = r.flow.logging.logger.With(
+		slog.String(LogKeyFlowID, newFlowID()),
+		slog.String(LogKeyFlow, labelOrUnnamed(r.flow.name)),
+	)
+
+	r.logger.Log(ctx, r.flow.logging.startLevel, "flow started")
+
+	return withLogger(ctx, r.logger)
+}
+
+// finishLogging logs that the flow finished with the given error.
+func (r *flowRun) finishLogging(err error) {
+	if r.logger == nil {
+		return
+	}
+
+	r.logLifecycleEvent(context.Background(), r.logger, "flow", time.Since(r.recorder.startedAt), err)
+}
+
+// loggingInterceptor injects a logger tagged with the executor being invoked into
+// the context of the components & logs the lifecycle events of each phase.
+func (r *flowRun) loggingInterceptor() Interceptor {
+	return func(ctx context.Context, inv Invocation, next Handler) (any, error) {
+		logger := r.logger.With(
+			slog.String(LogKeyExecutor, labelOrUnnamed(inv.Executor.Name())),
+			slog.Int(LogKeyLayerIdx, inv.LayerIdx),
+			slog.Int(LogKeyExecutorIdx, inv.ExecutorIdx),
+			slog.String(LogKeyPhase, string(inv.Phase)),
+		)
+
+		ctx = withLogger(ctx, logger)
+
+		logger.Log(ctx, r.flow.logging.startLevel, "executor phase started")
+
+		startedAt := time.Now()
+		result, err := next(ctx)
+
+		r.logLifecycleEvent(ctx, logger, "executor phase", time.Since(startedAt), err)
+
+		return result, err
+	}
+}
+
+// logLifecycleEvent logs that the given subject finished with the given error
+// at the level configured for the outcome of the subject.
+func (r *flowRun) logLifecycleEvent(ctx context.Context, logger *slog.Logger, subject string, duration time.Duration, err error) {
+	levels := r.flow.logging
+
+	switch classifyOutcome(ctx, err) {
+	case OutcomeSuccess:
+		logger.Log(ctx, levels.finishLevel, subject+" finished", slog.Duration("duration", duration))
+	case OutcomeCancelled, OutcomeTimedOut:
+		logger.Log(
+			ctx,
+			levels.cancelledLevel,
+			subject+" cancelled",
+			slog.Duration("duration", duration),
+			slog.Any("error", err),
+			slog.Any("cause", r.cancelCause(ctx)),
+		)
+	default:
+		logger.Log(ctx, levels.errorLevel, subject+" failed", slog.Duration("duration", duration), slog.Any("error", err))
+	}
+}
+
+// newFlowID returns a random ID identifying one execution of a flow.
+func newFlowID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,214 @@
+package component
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogging(t *testing.T) {
+	scenarios := []struct {
+		desc string
+		test func(t *testing.T)
+	}{
+		{
+			desc: "components log with the tags of their executor",
+			test: func(t *testing.T) {
+				buf := &syncBuffer{}
+
+				flow := NewExecutionFlowBuilder().
+					Append(
+						CreateSyncOrchestratingExecutor(
+							func(ctx context.Context) error {
+								LoggerFrom(ctx).Info("calculating fare")
+								return nil
+							},
+							WithName("fare"),
+						),
+					).
+					WithName("fare_calculation").
+					WithLogger(newTestLogger(buf, slog.LevelDebug)).
+					Get()
+
+				assert.Nil(t, ForkJoinFailingFast(context.Background(), flow))
+
+				records := buf.records(t)
+
+				messages := make([]string, 0, len(records))
+				for _, r := range records {
+					messages = append(messages, r["msg"].(string))
+				}
+
+				expected := []string{
+					"flow started",
+					"executor phase started",
+					"calculating fare",
+					"executor phase finished",
+					"flow finished",
+				}
+				assert.Equal(t, expected, messages)
+
+				flowID := records[0][LogKeyFlowID]
+				assert.NotEmpty(t, flowID)
+
+				for _, r := range records {
+					assert.Equal(t, flowID, r[LogKeyFlowID])
+					assert.Equal(t, "fare_calculation", r[LogKeyFlow])
+				}
+
+				componentRecord := records[2]
+				assert.Equal(t, "INFO", componentRecord["level"])
+				assert.Equal(t, "fare", componentRecord[LogKeyExecutor])
+				assert.Equal(t, 0.0, componentRecord[LogKeyLayerIdx])
+				assert.Equal(t, 0.0, componentRecord[LogKeyExecutorIdx])
+				assert.Equal(t, string(PhaseExecuteSync), componentRecord[LogKeyPhase])
+
+				assert.Equal(t, "DEBUG", records[3]["level"])
+				assert.Contains(t, records[3], "duration")
+			},
+		},
+		{
+			desc: "errors and cancellations are logged at their levels",
+			test: func(t *testing.T) {
+				buf := &syncBuffer{}
+				failingErr := errors.New("error from sync task")
+
+				flow := NewExecutionFlowBuilder().
+					Append(
+						executorOf(
+							CreateAsyncExecutor[int](
+								asyncComponentFunc[int](
+									func(ctx context.Context) (int, error) {
+										<-ctx.Done()
+										return 0, ctx.Err()
+									},
+								),
+								WithName("routing"),
+							),
+						),
+						CreateSyncOrchestratingExecutor(
+							func(ctx context.Context) error {
+								<-time.After(10 * time.Millisecond)
+								return failingErr
+							},
+							WithName("fare"),
+						),
+					).
+					WithLogger(newTestLogger(buf, slog.LevelInfo), WithCancelledLogLevel(slog.LevelInfo)).
+					Get()
+
+				flowErr := ForkJoinFailingFast(context.Background(), flow)
+				assert.Equal(t, &ExecutorError{Executor: "fare", Err: failingErr}, flowErr)
+
+				var records []map[string]any
+				assert.Eventually(t, func() bool {
+					records = buf.records(t)
+					return len(records) == 3
+				}, time.Second, time.Millisecond)
+
+				byMessage := make(map[string]map[string]any)
+				for _, r := range records {
+					byMessage[r["msg"].(string)+" "+labelOrUnnamed(stringOf(r[LogKeyExecutor]))] = r
+				}
+
+				failed := byMessage["executor phase failed fare"]
+				assert.Equal(t, "ERROR", failed["level"])
+				assert.Equal(t, failingErr.Error(), failed["error"])
+
+				flowFailed := byMessage["flow failed unnamed"]
+				assert.Equal(t, "ERROR", flowFailed["level"])
+
+				cancelled := byMessage["executor phase cancelled routing"]
+				assert.Equal(t, "INFO", cancelled["level"])
+				assert.Equal(t, flowErr.Error(), cancelled["cause"])
+			},
+		},
+		{
+			desc: "nil logger falls back to the default logger",
+			test: func(t *testing.T) {
+				var logger *slog.Logger
+
+				flow := NewExecutionFlowBuilder().
+					Append(
+						CreateSyncOrchestratingExecutor(
+							func(ctx context.Context) error {
+								logger = LoggerFrom(ctx)
+								return nil
+							},
+						),
+					).
+					WithLogger(nil).
+					Get()
+
+				assert.Nil(t, ForkJoinFailingFast(context.Background(), flow))
+				assert.NotNil(t, logger)
+			},
+		},
+		{
+			desc: "outside of flows",
+			test: func(t *testing.T) {
+				assert.Equal(t, slog.Default(), LoggerFrom(context.Background()))
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(sc.desc, sc.test)
+	}
+}
+
+func newTestLogger(buf *syncBuffer, level slog.Level) *slog.Logger {
+	return slog.New(
+		slog.NewJSONHandler(
+			buf,
+			&slog.HandlerOptions{
+				Level: level,
+			},
+		),
+	)
+}
+
+func stringOf(v any) string {
+	s, _ := v.(string)
+	return s
+}
+
+// syncBuffer is a bytes.Buffer that can be written to by concurrent components.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) records(t *testing.T) []map[string]any {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var result []map[string]any
+	for _, line := range bytes.Split(bytes.TrimSpace(b.buf.Bytes()), []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+
+		var r map[string]any
+		assert.Nil(t, json.Unmarshal(line, &r))
+
+		result = append(result, r)
+	}
+
+	return result
+}
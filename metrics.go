@@ -0,0 +1,177 @@
+package component
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Outcomes of an executor phase reported by MetricsCollector.
+const (
+	OutcomeSuccess   = "success"
+	OutcomeError     = "error"
+	OutcomeCancelled = "cancelled"
+	OutcomeTimedOut  = "timed_out"
+)
+
+type metricsConfigs struct {
+	namespace      string
+	latencyBuckets []float64
+}
+
+// MetricsOption customizes a MetricsCollector when it gets created.
+type MetricsOption func(*metricsConfigs)
+
+// WithMetricsNamespace sets the namespace of all metrics exposed by the collector.
+func WithMetricsNamespace(namespace string) MetricsOption {
+	return func(configs *metricsConfigs) {
+		configs.namespace = namespace
+	}
+}
+
+// WithLatencyBuckets sets the buckets, in seconds, of the latency histogram. If
+// no buckets are given, the default buckets of Prometheus will be used.
+func WithLatencyBuckets(buckets ...float64) MetricsOption {
+	return func(configs *metricsConfigs) {
+		if len(buckets) == 0 {
+			configs.latencyBuckets = prometheus.DefBuckets
+			return
+		}
+
+		configs.latencyBuckets = buckets
+	}
+}
+
+// MetricsCollector is a prometheus.Collector exposing the latency & outcome of
+// each executor phase, the time each phase spends blocked waiting for other
+// executors as well as the number of in-flight executions of each flow.
+// It must be registered with a Prometheus registry & attached to flows using
+// ExecutionFlowBuilder.WithMetricsCollector.
+type MetricsCollector struct {
+	latency  *prometheus.HistogramVec
+	outcomes *prometheus.CounterVec
+	blocked  *prometheus.HistogramVec
+	inFlight *prometheus.GaugeVec
+}
+
+// NewMetricsCollector returns a new MetricsCollector.
+func NewMetricsCollector(options ...MetricsOption) *MetricsCollector {
+	configs := &metricsConfigs{
+		latencyBuckets: prometheus.DefBuckets,
+	}
+
+	for _, o := range options {
+		o(configs)
+	}
+
+	return &MetricsCollector{
+		latency: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: configs.namespace,
+				Subsystem: "component",
+				Name:      "phase_duration_seconds",
+				Help:      "Latency of each executor phase.",
+				Buckets:   configs.latencyBuckets,
+			},
+			[]string{"flow", "executor", "phase"},
+		),
+		outcomes: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: configs.namespace,
+				Subsystem: "component",
+				Name:      "phase_outcomes_total",
+				Help:      "Number of executor phases by outcome.",
+			},
+			[]string{"flow", "executor", "phase", "outcome"},
+		),
+		blocked: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: configs.namespace,
+				Subsystem: "component",
+				Name:      "blocked_duration_seconds",
+				Help:      "Time each executor phase spends blocked waiting for the phase of another executor.",
+				Buckets:   configs.latencyBuckets,
+			},
+			[]string{"flow", "executor", "phase", "producer", "producer_phase"},
+		),
+		inFlight: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: configs.namespace,
+				Subsystem: "component",
+				Name:      "flows_in_flight",
+				Help:      "Number of flow executions currently in flight.",
+			},
+			[]string{"flow"},
+		),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *MetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.latency.Describe(ch)
+	c.outcomes.Describe(ch)
+	c.blocked.Describe(ch)
+	c.inFlight.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *MetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.latency.Collect(ch)
+	c.outcomes.Collect(ch)
+	c.blocked.Collect(ch)
+	c.inFlight.Collect(ch)
+}
+
+func (c *MetricsCollector) flowStarted(flowName string) {
+	c.inFlight.WithLabelValues(labelOrUnnamed(flowName)).Inc()
+}
+
+func (c *MetricsCollector) flowFinished(flowName string) {
+	c.inFlight.WithLabelValues(labelOrUnnamed(flowName)).Dec()
+}
+
+func (c *MetricsCollector) observeWait(flowName string, consumerName string, producerName string, w waitRecord) {
+	c.blocked.WithLabelValues(
+		labelOrUnnamed(flowName),
+		labelOrUnnamed(consumerName),
+		string(w.consumer.phase),
+		labelOrUnnamed(producerName),
+		string(w.producer.phase),
+	).Observe(w.endedAt.Sub(w.startedAt).Seconds())
+}
+
+// interceptor records the latency & outcome of each executor phase in the given flow.
+func (c *MetricsCollector) interceptor(flowName string) Interceptor {
+	flowLabel := labelOrUnnamed(flowName)
+
+	return func(ctx context.Context, inv Invocation, next Handler) (any, error) {
+		startedAt := time.Now()
+
+		result, err := next(ctx)
+
+		executorLabel := labelOrUnnamed(inv.Executor.Name())
+		phaseLabel := string(inv.Phase)
+
+		c.latency.WithLabelValues(flowLabel, executorLabel, phaseLabel).Observe(time.Since(startedAt).Seconds())
+		c.outcomes.WithLabelValues(flowLabel, executorLabel, phaseLabel, classifyOutcome(ctx, err)).Inc()
+
+		return result, err
+	}
+}
+
+// classifyOutcome returns the outcome of a phase that returned
+// the given error when being executed using the given context.
+func classifyOutcome(ctx context.Context, err error) string {
+	switch {
+	case err == nil:
+		return OutcomeSuccess
+	case errors.Is(err, context.DeadlineExceeded) || errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return OutcomeTimedOut
+	case errors.Is(err, context.Canceled) || ctx.Err() != nil || isCancellation(err):
+		return OutcomeCancelled
+	default:
+		return OutcomeError
+	}
+}
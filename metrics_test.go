@@ -0,0 +1,121 @@
+package component
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMetricsCollector(t *testing.T) {
+	scenarios := []struct {
+		desc string
+		test func(t *testing.T)
+	}{
+		{
+			desc: "latency and outcome of each executor phase are recorded",
+			test: func(t *testing.T) {
+				collector := NewMetricsCollector(WithMetricsNamespace("test"))
+
+				registry := prometheus.NewPedanticRegistry()
+				assert.Nil(t, registry.Register(collector))
+
+				mockSyncComponentWithLoading := &MockSyncComponentWithLoading[int, int]{}
+				mockSyncComponentWithLoading.On("Load", mock.Anything).
+					Run(func(args mock.Arguments) {
+						<-time.After(10 * time.Millisecond)
+					}).
+					Return(0, assert.AnError).
+					Once()
+				mockSyncComponentWithLoading.On("ExecuteSync", mock.Anything, mock.Anything).Return(1, nil).Once()
+
+				flow := NewExecutionFlowBuilder().
+					Append(
+						executorOf(
+							CreateAsyncExecutor[int](
+								asyncComponentFunc[int](
+									func(ctx context.Context) (int, error) {
+										return 1, nil
+									},
+								),
+								WithName("routing"),
+							),
+						),
+						executorOf(CreateSyncExecutorWithLoading[int, int](mockSyncComponentWithLoading, WithName("fare"))),
+					).
+					WithName("fare_calculation").
+					WithMetricsCollector(collector).
+					Get()
+
+				actual := ForkJoinFailingFast(context.Background(), flow)
+				assert.Nil(t, actual)
+
+				assert.Equal(t, 1.0, testutil.ToFloat64(collector.outcomes.WithLabelValues("fare_calculation", "routing", "execute_async", OutcomeSuccess)))
+				assert.Equal(t, 1.0, testutil.ToFloat64(collector.outcomes.WithLabelValues("fare_calculation", "fare", "load", OutcomeError)))
+				assert.Equal(t, 1.0, testutil.ToFloat64(collector.outcomes.WithLabelValues("fare_calculation", "fare", "execute_sync", OutcomeSuccess)))
+				assert.Equal(t, 0.0, testutil.ToFloat64(collector.inFlight.WithLabelValues("fare_calculation")))
+				assert.Equal(t, 3, testutil.CollectAndCount(collector, "test_component_phase_duration_seconds"))
+				assert.Equal(t, 1, testutil.CollectAndCount(collector, "test_component_blocked_duration_seconds"), "fare waits for its loading task")
+
+				lintProblems, err := testutil.CollectAndLint(collector)
+				assert.Nil(t, err)
+				assert.Empty(t, lintProblems)
+			},
+		},
+		{
+			desc: "in-flight flows are tracked",
+			test: func(t *testing.T) {
+				collector := NewMetricsCollector()
+
+				release := make(chan struct{})
+
+				flow := NewExecutionFlowBuilder().
+					Append(
+						CreateSyncOrchestratingExecutor(func(ctx context.Context) error {
+							<-release
+							return nil
+						}),
+					).
+					WithMetricsCollector(collector).
+					Get()
+
+				errChan := make(chan error, 1)
+				go func() {
+					errChan <- ForkJoinFailingFast(context.Background(), flow)
+				}()
+
+				assert.Eventually(t, func() bool {
+					return testutil.ToFloat64(collector.inFlight.WithLabelValues(unnamed)) == 1
+				}, time.Second, time.Millisecond)
+
+				close(release)
+
+				assert.Nil(t, <-errChan)
+				assert.Equal(t, 0.0, testutil.ToFloat64(collector.inFlight.WithLabelValues(unnamed)))
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(sc.desc, sc.test)
+	}
+}
+
+func TestClassifyOutcome(t *testing.T) {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	timedOutCtx, cancel := context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+
+	assert.Equal(t, OutcomeSuccess, classifyOutcome(context.Background(), nil))
+	assert.Equal(t, OutcomeError, classifyOutcome(context.Background(), assert.AnError))
+	assert.Equal(t, OutcomeCancelled, classifyOutcome(cancelledCtx, assert.AnError))
+	assert.Equal(t, OutcomeTimedOut, classifyOutcome(timedOutCtx, assert.AnError))
+	assert.Equal(t, OutcomeTimedOut, classifyOutcome(context.Background(), context.DeadlineExceeded))
+}
@@ -13,6 +13,20 @@ type MockIExecutor struct {
 	mock.Mock
 }
 
+// ComponentType provides a mock function with given fields:
+func (_m *MockIExecutor) ComponentType() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // InvokeExecutingTask provides a mock function with given fields: ctx
 func (_m *MockIExecutor) InvokeExecutingTask(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -27,6 +41,36 @@ func (_m *MockIExecutor) InvokeExecutingTask(ctx context.Context) error {
 	return r0
 }
 
+// Name provides a mock function with given fields:
+func (_m *MockIExecutor) Name() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Tags provides a mock function with given fields:
+func (_m *MockIExecutor) Tags() map[string]string {
+	ret := _m.Called()
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
+}
+
 // canBeInvokedAsync provides a mock function with given fields:
 func (_m *MockIExecutor) canBeInvokedAsync() bool {
 	ret := _m.Called()
@@ -46,6 +90,22 @@ func (_m *MockIExecutor) cancel(err error) {
 	_m.Called(err)
 }
 
+// dependencies provides a mock function with given fields:
+func (_m *MockIExecutor) dependencies() []IExecutor {
+	ret := _m.Called()
+
+	var r0 []IExecutor
+	if rf, ok := ret.Get(0).(func() []IExecutor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]IExecutor)
+		}
+	}
+
+	return r0
+}
+
 // invokeAsyncTask provides a mock function with given fields: ctx
 func (_m *MockIExecutor) invokeAsyncTask(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -74,6 +134,50 @@ func (_m *MockIExecutor) invokeSyncTask(ctx context.Context) error {
 	return r0
 }
 
+// isNameExplicit provides a mock function with given fields:
+func (_m *MockIExecutor) isNameExplicit() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// kind provides a mock function with given fields:
+func (_m *MockIExecutor) kind() ExecutorKind {
+	ret := _m.Called()
+
+	var r0 ExecutorKind
+	if rf, ok := ret.Get(0).(func() ExecutorKind); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(ExecutorKind)
+	}
+
+	return r0
+}
+
+// phaseTasks provides a mock function with given fields:
+func (_m *MockIExecutor) phaseTasks() map[Phase]interface{} {
+	ret := _m.Called()
+
+	var r0 map[Phase]interface{}
+	if rf, ok := ret.Get(0).(func() map[Phase]interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[Phase]interface{})
+		}
+	}
+
+	return r0
+}
+
 // NewMockIExecutor creates a new instance of MockIExecutor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIExecutor(t interface {
@@ -0,0 +1,331 @@
+package component
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Status represents the status of an executor or one of its phases.
+type Status string
+
+// Various statuses reported in ExecutionReport.
+const (
+	StatusNotStarted Status = "not_started" // StatusNotStarted represents a phase that never started
+	StatusRunning    Status = "running"     // StatusRunning represents a phase still running when the flow returned
+	StatusSucceeded  Status = "succeeded"   // StatusSucceeded represents a phase that returned no error
+	StatusFailed     Status = "failed"      // StatusFailed represents a phase that returned an error
+	StatusCancelled  Status = "cancelled"   // StatusCancelled represents a phase that was cancelled
+	StatusTimedOut   Status = "timed_out"   // StatusTimedOut represents a phase whose context timed out
+)
+
+// ExecutionReport describes what happened inside one execution of an
+// ExecutionFlow. It is a snapshot taken when the flow returned, executors
+// that were still running at that moment are reported as StatusRunning.
+type ExecutionReport struct {
+	FlowName  string
+	StartedAt time.Time
+	EndedAt   time.Time
+	Err       error
+	Executors []ExecutorReport
+}
+
+// Duration returns how long the flow took to return.
+func (r ExecutionReport) Duration() time.Duration {
+	return r.EndedAt.Sub(r.StartedAt)
+}
+
+// ExecutorReport describes what happened to an executor in a flow execution.
+type ExecutorReport struct {
+	Name          string
+	ComponentType string
+	Tags          map[string]string
+	Kind          ExecutorKind
+	LayerIdx      int
+	ExecutorIdx   int
+	// Load is only relevant to executors of components with loading.
+	Load PhaseReport
+	// Loads is only relevant to executors of SyncComponentWithLoaders,
+	// it lists the phases of their loaders sorted by name.
+	Loads []PhaseReport
+	// Async is only relevant to custom executors with an async part, which
+	// runs concurrently with the other executors of its layer before the
+	// sync part, reported as Execute, gets its turn in the sync lane.
+	Async   PhaseReport
+	Execute PhaseReport
+	Status  Status
+	Err     error
+	// CancelCause is the reason why this executor got cancelled, if it did.
+	CancelCause error
+	// Waits lists the times this executor got blocked waiting for other executors.
+	Waits []WaitReport
+}
+
+// phases returns all phases of this executor.
+func (r ExecutorReport) phases() []PhaseReport {
+	result := make([]PhaseReport, 0, len(r.Loads)+3)
+	result = append(result, r.Load)
+	result = append(result, r.Loads...)
+
+	return append(result, r.Async, r.Execute)
+}
+
+// isPreparing returns whether any phase of this executor
+// running before its main logic is still running.
+func (r ExecutorReport) isPreparing() bool {
+	if r.Load.Status == StatusRunning || r.Async.Status == StatusRunning {
+		return true
+	}
+
+	for _, p := range r.Loads {
+		if p.Status == StatusRunning {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BlockedTime returns the total time this executor spent blocked waiting for other executors.
+func (r ExecutorReport) BlockedTime() time.Duration {
+	var result time.Duration
+	for _, w := range r.Waits {
+		result += w.Duration()
+	}
+
+	return result
+}
+
+// WaitReport describes a phase of an executor being blocked while
+// waiting for the task of a phase of another executor to complete.
+type WaitReport struct {
+	// Phase is the phase of the executor that got blocked.
+	Phase               Phase
+	ProducerName        string
+	ProducerLayerIdx    int
+	ProducerExecutorIdx int
+	ProducerPhase       Phase
+	StartedAt           time.Time
+	EndedAt             time.Time
+}
+
+// Duration returns how long the executor was blocked.
+func (w WaitReport) Duration() time.Duration {
+	return w.EndedAt.Sub(w.StartedAt)
+}
+
+// PhaseReport describes what happened to a phase of an executor.
+type PhaseReport struct {
+	Phase     Phase
+	StartedAt time.Time
+	EndedAt   time.Time
+	Status    Status
+	Err       error
+}
+
+// Duration returns how long the phase took, or 0 if it did not complete.
+func (p PhaseReport) Duration() time.Duration {
+	if p.StartedAt.IsZero() || p.EndedAt.IsZero() {
+		return 0
+	}
+
+	return p.EndedAt.Sub(p.StartedAt)
+}
+
+type phaseKey struct {
+	layerIdx    int
+	executorIdx int
+	phase       Phase
+}
+
+// executionRecorder keeps track of the phases of executors in a flow run.
+type executionRecorder struct {
+	mu        sync.Mutex
+	startedAt time.Time
+	endedAt   time.Time
+	err       error
+	phases    map[phaseKey]PhaseReport
+	waits     []waitRecord
+}
+
+func newExecutionRecorder() *executionRecorder {
+	return &executionRecorder{
+		phases: make(map[phaseKey]PhaseReport),
+	}
+}
+
+func (r *executionRecorder) start() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.startedAt = time.Now()
+}
+
+func (r *executionRecorder) finish(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.endedAt = time.Now()
+	r.err = err
+}
+
+func (r *executionRecorder) record(key phaseKey, p PhaseReport) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.phases[key] = p
+}
+
+func (r *executionRecorder) recordWait(w waitRecord) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.waits = append(r.waits, w)
+}
+
+func (r *executionRecorder) phase(key phaseKey) PhaseReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, ok := r.phases[key]
+	if !ok {
+		return PhaseReport{
+			Phase:  key.phase,
+			Status: StatusNotStarted,
+		}
+	}
+
+	return p
+}
+
+// recordingInterceptor records the timings & outcome of each executor phase.
+func (r *flowRun) recordingInterceptor() Interceptor {
+	return func(ctx context.Context, inv Invocation, next Handler) (any, error) {
+		key := phaseKey{
+			layerIdx:    inv.LayerIdx,
+			executorIdx: inv.ExecutorIdx,
+			phase:       inv.Phase,
+		}
+
+		p := PhaseReport{
+			Phase:     inv.Phase,
+			StartedAt: time.Now(),
+			Status:    StatusRunning,
+		}
+
+		r.recorder.record(key, p)
+
+		result, err := next(ctx)
+
+		p.EndedAt = time.Now()
+		p.Status = statusOf(ctx, err)
+		p.Err = err
+
+		r.recorder.record(key, p)
+
+		return result, err
+	}
+}
+
+// statusOf returns the status of a phase that returned the
+// given error when being executed using the given context.
+func statusOf(ctx context.Context, err error) Status {
+	switch classifyOutcome(ctx, err) {
+	case OutcomeSuccess:
+		return StatusSucceeded
+	case OutcomeTimedOut:
+		return StatusTimedOut
+	case OutcomeCancelled:
+		return StatusCancelled
+	default:
+		return StatusFailed
+	}
+}
+
+// report returns the ExecutionReport of this run.
+func (r *flowRun) report() ExecutionReport {
+	r.recorder.mu.Lock()
+	result := ExecutionReport{
+		FlowName:  r.flow.name,
+		StartedAt: r.recorder.startedAt,
+		EndedAt:   r.recorder.endedAt,
+		Err:       r.recorder.err,
+	}
+	waits := append([]waitRecord(nil), r.recorder.waits...)
+	r.recorder.mu.Unlock()
+
+	cancelledFromLayerIdx, cancelCause := r.cancellation()
+
+	for layerIdx, executors := range r.flow.Executors {
+		for executorIdx, e := range executors {
+			er := ExecutorReport{
+				Name:          e.Name(),
+				ComponentType: e.ComponentType(),
+				Tags:          e.Tags(),
+				Kind:          e.kind(),
+				LayerIdx:      layerIdx,
+				ExecutorIdx:   executorIdx,
+				Load:          r.recorder.phase(phaseKey{layerIdx, executorIdx, PhaseLoad}),
+				Execute:       r.recorder.phase(phaseKey{layerIdx, executorIdx, executingPhaseOf(e)}),
+			}
+
+			if e.kind() == KindCustom {
+				er.Async = r.recorder.phase(phaseKey{layerIdx, executorIdx, PhaseExecuteAsync})
+			}
+
+			for _, phase := range loaderPhasesOf(e) {
+				er.Loads = append(er.Loads, r.recorder.phase(phaseKey{layerIdx, executorIdx, phase}))
+			}
+
+			// Background executors are never cancelled by their flow
+			isCancelled := cancelCause != nil && layerIdx >= cancelledFromLayerIdx && e.kind() != KindBackground
+
+			switch {
+			case er.Execute.Status != StatusNotStarted:
+				er.Status = er.Execute.Status
+				er.Err = er.Execute.Err
+			case isCancelled:
+				er.Status = StatusCancelled
+			case er.isPreparing():
+				er.Status = StatusRunning
+			default:
+				er.Status = StatusNotStarted
+			}
+
+			if er.Status == StatusCancelled {
+				er.CancelCause = cancelCause
+			}
+
+			for _, w := range waits {
+				if w.consumer.layerIdx == layerIdx && w.consumer.executorIdx == executorIdx {
+					er.Waits = append(er.Waits, r.waitReport(w))
+				}
+			}
+
+			result.Executors = append(result.Executors, er)
+		}
+	}
+
+	return result
+}
+
+func (r *flowRun) waitReport(w waitRecord) WaitReport {
+	return WaitReport{
+		Phase:               w.consumer.phase,
+		ProducerName:        r.executorAt(w.producer).Name(),
+		ProducerLayerIdx:    w.producer.layerIdx,
+		ProducerExecutorIdx: w.producer.executorIdx,
+		ProducerPhase:       w.producer.phase,
+		StartedAt:           w.startedAt,
+		EndedAt:             w.endedAt,
+	}
+}
+
+// executingPhaseOf returns the phase in which the given executor executes its main logic.
+func executingPhaseOf(e IExecutor) Phase {
+	if e.kind().isAsync() {
+		return PhaseExecuteAsync
+	}
+
+	return PhaseExecuteSync
+}
@@ -0,0 +1,268 @@
+package component
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestForkJoinFailingFastWithReport(t *testing.T) {
+	scenarios := []struct {
+		desc string
+		test func(t *testing.T)
+	}{
+		{
+			desc: "empty flow",
+			test: func(t *testing.T) {
+				report, err := ForkJoinFailingFastWithReport(context.Background(), ExecutionFlow{})
+
+				assert.Nil(t, err)
+				assert.Empty(t, report.Executors)
+				assert.False(t, report.EndedAt.Before(report.StartedAt))
+			},
+		},
+		{
+			desc: "every executor is reported with its phases",
+			test: func(t *testing.T) {
+				mockSyncComponentWithLoading := &MockSyncComponentWithLoading[int, int]{}
+				mockSyncComponentWithLoading.On("Load", mock.Anything).
+					Run(func(args mock.Arguments) {
+						<-time.After(10 * time.Millisecond)
+					}).
+					Return(0, assert.AnError).
+					Once()
+				mockSyncComponentWithLoading.On("ExecuteSync", mock.Anything, mock.Anything).Return(1, nil).Once()
+
+				mockSyncComponent := &MockSyncComponent[int]{}
+				mockSyncComponent.On("ExecuteSync", mock.Anything).Return(2, nil).Once()
+
+				flow := NewExecutionFlowBuilder().
+					Append(
+						executorOf(
+							CreateAsyncExecutor[int](
+								asyncComponentFunc[int](
+									func(ctx context.Context) (int, error) {
+										return 1, nil
+									},
+								),
+								WithName("routing"),
+							),
+						),
+						executorOf(CreateSyncExecutorWithLoading[int, int](mockSyncComponentWithLoading, WithName("fare"))),
+					).
+					NextLayer().
+					Append(executorOf(CreateSyncExecutor[int](mockSyncComponent, WithName("rounding")))).
+					WithName("fare_calculation").
+					Get()
+
+				report, err := ForkJoinFailingFastWithReport(context.Background(), flow)
+				assert.Nil(t, err)
+
+				assert.Equal(t, "fare_calculation", report.FlowName)
+				assert.Nil(t, report.Err)
+				assert.True(t, report.Duration() > 0)
+				assert.Equal(t, 3, len(report.Executors))
+
+				routing := report.Executors[0]
+				assert.Equal(t, "routing", routing.Name)
+				assert.Equal(t, KindAsync, routing.Kind)
+				assert.Equal(t, StatusSucceeded, routing.Status)
+				assert.Equal(t, StatusNotStarted, routing.Load.Status)
+				assert.Equal(t, PhaseExecuteAsync, routing.Execute.Phase)
+				assert.False(t, routing.Execute.StartedAt.IsZero())
+
+				fare := report.Executors[1]
+				assert.Equal(t, KindSyncWithLoading, fare.Kind)
+				assert.Equal(t, StatusSucceeded, fare.Status, "load error is handled by the component itself")
+				assert.Equal(t, StatusFailed, fare.Load.Status)
+				assert.Equal(t, assert.AnError, fare.Load.Err)
+				assert.True(t, fare.Load.Duration() >= 10*time.Millisecond)
+				assert.False(t, fare.Execute.StartedAt.Before(fare.Load.EndedAt), "execute phase starts after loading completes")
+
+				rounding := report.Executors[2]
+				assert.Equal(t, KindSync, rounding.Kind)
+				assert.Equal(t, 1, rounding.LayerIdx)
+				assert.Equal(t, 0, rounding.ExecutorIdx)
+				assert.Equal(t, StatusSucceeded, rounding.Status)
+			},
+		},
+		{
+			desc: "time blocked waiting for other executors is attributed to them",
+			test: func(t *testing.T) {
+				routing, _ := CreateAsyncExecutor[int](
+					asyncComponentFunc[int](
+						func(ctx context.Context) (int, error) {
+							<-time.After(20 * time.Millisecond)
+							return 1, nil
+						},
+					),
+					WithName("routing"),
+				)
+
+				fare := CreateSyncOrchestratingExecutor(
+					func(ctx context.Context) error {
+						assert.Equal(t, 1, AwaitOrDefault(ctx, routing.GetExecutingTask(), 0))
+
+						// Outcomes of completed tasks are returned without blocking
+						assert.Equal(t, 1, AwaitOrDefault(ctx, routing.GetExecutingTask(), 0))
+
+						return nil
+					},
+					WithName("fare"),
+				)
+
+				flow := NewExecutionFlowBuilder().
+					Append(routing, fare).
+					Get()
+
+				report, err := ForkJoinFailingFastWithReport(context.Background(), flow)
+				assert.Nil(t, err)
+
+				assert.Empty(t, report.Executors[0].Waits)
+				assert.Equal(t, time.Duration(0), report.Executors[0].BlockedTime())
+
+				waits := report.Executors[1].Waits
+				assert.Equal(t, 1, len(waits))
+				assert.Equal(t, PhaseExecuteSync, waits[0].Phase)
+				assert.Equal(t, "routing", waits[0].ProducerName)
+				assert.Equal(t, 0, waits[0].ProducerLayerIdx)
+				assert.Equal(t, 0, waits[0].ProducerExecutorIdx)
+				assert.Equal(t, PhaseExecuteAsync, waits[0].ProducerPhase)
+				assert.True(t, report.Executors[1].BlockedTime() > 10*time.Millisecond)
+				assert.True(t, report.Executors[1].BlockedTime() <= report.Executors[1].Execute.Duration())
+			},
+		},
+		{
+			desc: "async executor with loading waits for its loading task & its dependencies",
+			test: func(t *testing.T) {
+				routing, routingFuture := CreateAsyncExecutor[int](
+					asyncComponentFunc[int](
+						func(ctx context.Context) (int, error) {
+							<-time.After(20 * time.Millisecond)
+							return 2, nil
+						},
+					),
+					WithName("routing"),
+				)
+
+				mockAsyncComponentWithLoading := &MockAsyncComponentWithLoading[int, int]{}
+				mockAsyncComponentWithLoading.On("Load", mock.Anything).
+					Run(func(args mock.Arguments) {
+						<-time.After(10 * time.Millisecond)
+					}).
+					Return(3, nil).
+					Once()
+				mockAsyncComponentWithLoading.On("Execute", mock.Anything, LoadData[int]{Data: 3}).
+					Return(func(ctx context.Context, data LoadData[int]) (int, error) {
+						assert.True(t, routingFuture.IsResolved(), "dependencies must complete before Execute")
+
+						distance, err := routingFuture.Get(ctx)
+						return data.Data * distance, err
+					}).
+					Once()
+
+				fare, fareFuture := CreateAsyncExecutorWithLoading[int, int](mockAsyncComponentWithLoading, WithName("fare"), DependsOn(routing))
+
+				flow := NewExecutionFlowBuilder().
+					Append(fare, routing).
+					Get()
+
+				report, err := ForkJoinFailingFastWithReport(context.Background(), flow)
+				assert.Nil(t, err)
+				assert.Equal(t, 6, fareFuture.GetOrDefault(context.Background(), 0))
+
+				fareReport := report.Executors[0]
+				assert.Equal(t, KindAsyncWithLoading, fareReport.Kind)
+				assert.Equal(t, StatusSucceeded, fareReport.Status)
+				assert.Equal(t, PhaseLoad, fareReport.Load.Phase)
+				assert.Equal(t, StatusSucceeded, fareReport.Load.Status)
+				assert.Equal(t, PhaseExecuteAsync, fareReport.Execute.Phase)
+				assert.Equal(t, StatusSucceeded, fareReport.Execute.Status)
+				assert.False(t, fareReport.Execute.StartedAt.Before(fareReport.Load.EndedAt))
+
+				if assert.NotEmpty(t, fareReport.Waits) {
+					assert.Equal(t, "routing", fareReport.Waits[0].ProducerName)
+					assert.Equal(t, PhaseExecuteAsync, fareReport.Waits[0].Phase)
+				}
+
+				mock.AssertExpectationsForObjects(t, mockAsyncComponentWithLoading)
+			},
+		},
+		{
+			desc: "phases time out separately",
+			test: func(t *testing.T) {
+				mockAsyncComponentWithLoading := &MockAsyncComponentWithLoading[int, int]{}
+				mockAsyncComponentWithLoading.On("Load", mock.Anything).
+					Return(func(ctx context.Context) (int, error) {
+						<-ctx.Done()
+						return 0, ctx.Err()
+					}).
+					Once()
+				mockAsyncComponentWithLoading.On("Execute", mock.Anything, LoadData[int]{Err: context.DeadlineExceeded}).
+					Return(1, nil).
+					Once()
+
+				e, _ := CreateAsyncExecutorWithLoading[int, int](mockAsyncComponentWithLoading, WithName("fare"), WithLoadTimeout(10*time.Millisecond))
+
+				flow := NewExecutionFlowBuilder().
+					Append(e).
+					Get()
+
+				report, err := ForkJoinFailingFastWithReport(context.Background(), flow)
+				assert.Nil(t, err)
+
+				assert.Equal(t, StatusTimedOut, report.Executors[0].Load.Status)
+				assert.Equal(t, StatusSucceeded, report.Executors[0].Execute.Status)
+				assert.Equal(t, StatusSucceeded, report.Executors[0].Status)
+
+				mock.AssertExpectationsForObjects(t, mockAsyncComponentWithLoading)
+			},
+		},
+		{
+			desc: "failing executor cancels the rest of its layer",
+			test: func(t *testing.T) {
+				failingErr := errors.New("error from sync task")
+
+				failing := CreateSyncOrchestratingExecutor(
+					func(ctx context.Context) error {
+						return failingErr
+					},
+					WithName("failing"),
+				)
+
+				skipped := CreateSyncOrchestratingExecutor(
+					func(ctx context.Context) error {
+						return nil
+					},
+					WithName("skipped"),
+				)
+
+				flow := NewExecutionFlowBuilder().
+					Append(failing, skipped).
+					Get()
+
+				report, err := ForkJoinFailingFastWithReport(context.Background(), flow)
+
+				assert.Equal(t, &ExecutorError{Executor: "failing", Err: failingErr}, err)
+				assert.Equal(t, err, report.Err)
+
+				assert.Equal(t, StatusFailed, report.Executors[0].Status)
+				assert.Equal(t, failingErr, report.Executors[0].Err)
+				assert.Nil(t, report.Executors[0].CancelCause)
+
+				assert.Equal(t, StatusCancelled, report.Executors[1].Status)
+				assert.Equal(t, StatusNotStarted, report.Executors[1].Execute.Status)
+				assert.Equal(t, err, report.Executors[1].CancelCause)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(sc.desc, sc.test)
+	}
+}
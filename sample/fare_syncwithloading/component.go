@@ -2,7 +2,6 @@ package fare
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/jamestrandung/go-component"
 	"github.com/jamestrandung/go-component/sample/fare_syncwithloading/dependencies"
@@ -11,29 +10,41 @@ import (
 // Component is in charge of calculating the fare for
 // travelling from A to B on a particular vehicle.
 type Component struct {
-	configStore dependencies.IConfigStore
-	input       Input
+	configLoader *component.BatchLoader[int64, dependencies.Configs]
+	input        Input
 }
 
 func (c Component) Load(ctx context.Context) (dependencies.Configs, error) {
-	return c.configStore.FetchConfigs(ctx, c.input.GetVehicleTypeID())
+	return c.configLoader.Load(ctx, c.input.GetVehicleTypeID())
 }
 
 func (c Component) ExecuteSync(ctx context.Context, data component.LoadData[dependencies.Configs]) (output, error) {
 	if data.Err != nil {
-		fmt.Printf("error fetching configs for vehicle %v: %v \n", c.input.GetVehicleTypeID(), data.Err.Error())
+		component.LoggerFrom(ctx).Error("error fetching configs", "vehicle_type_id", c.input.GetVehicleTypeID(), "error", data.Err)
 
 		return output{}, data.Err
 	}
 
 	configs := data.Data
 
-	kmFare := configs.PerKMFare * c.input.GetDistanceInKM()
-	minuteFare := configs.PerMinuteFare * c.input.GetDurationInSeconds() / 60
+	// Fares cannot be calculated without routing, the
+	// flow fails with the error of the routing component.
+	distanceInKM, err := c.input.GetDistanceInKM(ctx)
+	if err != nil {
+		return output{}, err
+	}
+
+	durationInSeconds, err := c.input.GetDurationInSeconds(ctx)
+	if err != nil {
+		return output{}, err
+	}
+
+	kmFare := configs.PerKMFare * distanceInKM
+	minuteFare := configs.PerMinuteFare * durationInSeconds / 60
 
 	fareBeforeSurge := configs.StartingFare + kmFare + minuteFare
 
-	c.input.GetRunningFare().Amount = fareBeforeSurge * c.input.GetSurge()
+	c.input.GetRunningFare().Amount = fareBeforeSurge * c.input.GetSurge(ctx)
 
 	return output{
 		metadata: Metadata{
@@ -15,7 +15,7 @@ type Configs struct {
 }
 
 type IConfigStore interface {
-	FetchConfigs(ctx context.Context, vehicleTypeID int64) (Configs, error)
+	FetchConfigs(ctx context.Context, vehicleTypeIDs []int64) (map[int64]Configs, error)
 }
 
 type ConfigStore struct{}
@@ -24,16 +24,21 @@ func NewConfigStore() *ConfigStore {
 	return &ConfigStore{}
 }
 
-func (s *ConfigStore) FetchConfigs(ctx context.Context, vehicleTypeID int64) (Configs, error) {
-	fmt.Printf("fetching configurations for vehicle %v\n", vehicleTypeID)
+func (s *ConfigStore) FetchConfigs(ctx context.Context, vehicleTypeIDs []int64) (map[int64]Configs, error) {
+	fmt.Printf("fetching configurations for vehicles %v\n", vehicleTypeIDs)
 
 	if utils.FlipCoin() {
-		return Configs{}, errors.New("config store is down")
+		return nil, errors.New("config store is down")
 	}
 
-	return Configs{
-		StartingFare:  1.5,
-		PerKMFare:     2,
-		PerMinuteFare: 3,
-	}, nil
+	result := make(map[int64]Configs, len(vehicleTypeIDs))
+	for _, vehicleTypeID := range vehicleTypeIDs {
+		result[vehicleTypeID] = Configs{
+			StartingFare:  1.5,
+			PerKMFare:     2,
+			PerMinuteFare: 3,
+		}
+	}
+
+	return result, nil
 }
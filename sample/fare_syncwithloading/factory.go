@@ -6,26 +6,25 @@ import (
 )
 
 type factory struct {
-	configStore dependencies.IConfigStore
+	configLoader *component.BatchLoader[int64, dependencies.Configs]
 }
 
 var f factory
 
-func InitializeFactory(mapService dependencies.IConfigStore) {
+func InitializeFactory(configStore dependencies.IConfigStore) {
 	f = factory{
-		configStore: mapService,
+		// Configs of all concurrent flows get fetched in bulk
+		configLoader: component.NewBatchLoader(configStore.FetchConfigs),
 	}
 }
 
-func GetExecutorFuture(input Input) (component.ExecutorWithLoading[dependencies.Configs, output], FareFuture) {
+func GetExecutorFuture(input Input, options ...component.ExecutorOption) (component.ExecutorWithLoading[dependencies.Configs, output], FareFuture) {
 	c := Component{
-		configStore: f.configStore,
-		input:       input,
+		configLoader: f.configLoader,
+		input:        input,
 	}
 
-	e := component.CreateSyncExecutorWithLoading[dependencies.Configs, output](c)
+	e, fut := component.CreateSyncExecutorWithLoading[dependencies.Configs, output](c, options...)
 
-	return e, future{
-		task: e.GetExecutingTask(),
-	}
+	return e, future{fut}
 }
@@ -1,16 +1,19 @@
 package fare
 
-import "github.com/jamestrandung/go-concurrency/v2/async"
+import (
+	"context"
+
+	"github.com/jamestrandung/go-component"
+)
 
 type FareFuture interface {
-	GetMetadata() Metadata
+	GetMetadata(ctx context.Context) Metadata
 }
 
 type future struct {
-	task async.Task[output]
+	component.Future[output]
 }
 
-func (f future) GetMetadata() Metadata {
-	r := f.task.ResultOrDefault(output{})
-	return r.metadata
+func (f future) GetMetadata(ctx context.Context) Metadata {
+	return component.ProjectOrDefault(ctx, f.Future, func(o output) Metadata { return o.metadata }, Metadata{})
 }
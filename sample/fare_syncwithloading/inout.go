@@ -1,12 +1,16 @@
 package fare
 
-import "github.com/jamestrandung/go-component/sample/dto"
+import (
+	"context"
+
+	"github.com/jamestrandung/go-component/sample/dto"
+)
 
 type Input interface {
 	GetVehicleTypeID() int64
-	GetSurge() float64
-	GetDistanceInKM() float64
-	GetDurationInSeconds() float64
+	GetSurge(ctx context.Context) float64
+	GetDistanceInKM(ctx context.Context) (float64, error)
+	GetDurationInSeconds(ctx context.Context) (float64, error)
 	GetRunningFare() *dto.Fare
 }
 
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/jamestrandung/go-component"
@@ -46,7 +47,9 @@ func main() {
 	// component as the inputs of another component.
 	//
 	// Each component will automatically block & wait if the
-	// component it depends on has not completed yet.
+	// component it depends on has not completed yet. The time
+	// spent waiting is attributed to the component it depends
+	// on in the ExecutionReport.
 	fareExecutor, fareFuture := fare.GetExecutorFuture(
 		struct {
 			request.FareCalculationRequest
@@ -59,30 +62,49 @@ func main() {
 			surgeFuture,
 			runningFare,
 		},
+		// Declared dependencies show up in diagrams of the flow
+		component.DependsOn(routingExecutor, surgeExecutor),
 	)
 	roundingExecutor := rounding.GetExecutor(runningFare)
 
 	// The order of appending is the same order that
 	// synchronous components will get executed.
-	executionFlow := component.NewExecutionFlowBuilder().
+	executionFlow, err := component.NewExecutionFlowBuilder().
 		Append(
 			routingExecutor,
 			surgeExecutor,
 			fareExecutor,
 			roundingExecutor,
-		).Get()
+		).
+		WithName("fare_calculation").
+		// Components log via component.LoggerFrom(ctx) to get
+		// their logs tagged with the flow & executor.
+		WithLogger(slog.Default(), component.WithFinishLogLevel(slog.LevelInfo)).
+		Build()
+	if err != nil {
+		fmt.Printf("invalid execution flow: %v \n", err.Error())
+
+		return
+	}
 
 	// ForkJoin will execute all async components and loading executors in parallel to
 	// maximize performance. At the same time, it will execute synchronous components
 	// 1-by-1 in the exact order in which they were appended to the execution flow.
 	//
 	// The very first error thrown by any executor will end ForkJoin immediately.
-	if err := component.ForkJoinFailingFast(context.Background(), executionFlow); err != nil {
+	ctx := context.Background()
+
+	report, err := component.ForkJoinFailingFastWithReport(ctx, executionFlow)
+	if err != nil {
 		fmt.Printf("ending execution flow early due to error: %v \n", err.Error())
 
 		return
 	}
 
 	fmt.Printf("calculated fare: %v\n", runningFare.GetRunningFare().Amount)
-	fmt.Printf("applied fare configs: %v\n", fareFuture.GetMetadata())
+	fmt.Printf("applied fare configs: %v\n", fareFuture.GetMetadata(ctx))
+
+	for _, e := range report.Executors {
+		fmt.Printf("%v blocked for %v\n", e.Name, e.BlockedTime())
+	}
 }
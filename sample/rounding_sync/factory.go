@@ -9,5 +9,7 @@ func GetExecutor(input Input) component.Executor[any] {
 		input: input,
 	}
 
-	return component.CreateSyncExecutor[any](c)
+	e, _ := component.CreateSyncExecutor[any](c)
+
+	return e
 }
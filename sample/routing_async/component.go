@@ -2,8 +2,8 @@ package routing
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/jamestrandung/go-component"
 	"github.com/jamestrandung/go-component/sample/routing_async/dependencies"
 )
 
@@ -24,7 +24,7 @@ func (c Component) Execute(ctx context.Context) (output, error) {
 	)
 
 	if err != nil {
-		fmt.Printf("error fetching travel plan: %v \n", err.Error())
+		component.LoggerFrom(ctx).Warn("error fetching travel plan", "error", err)
 
 		travelPlan = c.calculateFallbackTravelPlan()
 	}
@@ -23,9 +23,7 @@ func GetExecutorFuture(input Input) (component.Executor[output], RoutingFuture)
 		input:      input,
 	}
 
-	e := component.CreateAsyncExecutor[output](c)
+	e, fut := component.CreateAsyncExecutor[output](c)
 
-	return e, future{
-		task: e.GetExecutingTask(),
-	}
+	return e, future{fut}
 }
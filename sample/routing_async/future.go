@@ -1,22 +1,24 @@
 package routing
 
-import "github.com/jamestrandung/go-concurrency/v2/async"
+import (
+	"context"
+
+	"github.com/jamestrandung/go-component"
+)
 
 type RoutingFuture interface {
-	GetDistanceInKM() float64
-	GetDurationInSeconds() float64
+	GetDistanceInKM(ctx context.Context) (float64, error)
+	GetDurationInSeconds(ctx context.Context) (float64, error)
 }
 
 type future struct {
-	task async.Task[output]
+	component.Future[output]
 }
 
-func (f future) GetDistanceInKM() float64 {
-	r := f.task.ResultOrDefault(output{})
-	return r.distanceInKM
+func (f future) GetDistanceInKM(ctx context.Context) (float64, error) {
+	return component.Project(ctx, f.Future, func(o output) float64 { return o.distanceInKM })
 }
 
-func (f future) GetDurationInSeconds() float64 {
-	r := f.task.ResultOrDefault(output{})
-	return r.durationInSeconds
+func (f future) GetDurationInSeconds(ctx context.Context) (float64, error) {
+	return component.Project(ctx, f.Future, func(o output) float64 { return o.durationInSeconds })
 }
@@ -2,8 +2,8 @@ package surge
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/jamestrandung/go-component"
 	"github.com/jamestrandung/go-component/sample/surge_async/dependencies"
 )
 
@@ -25,7 +25,7 @@ func (c Component) Execute(ctx context.Context) (output, error) {
 	)
 
 	if err != nil {
-		fmt.Printf("error fetching surge: %v \n", err.Error())
+		component.LoggerFrom(ctx).Warn("error fetching surge", "error", err)
 
 		surge = fallbackSurge
 	}
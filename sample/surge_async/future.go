@@ -1,16 +1,20 @@
 package surge
 
-import "github.com/jamestrandung/go-concurrency/v2/async"
+import (
+	"context"
+
+	"github.com/jamestrandung/go-component"
+)
 
 type SurgeFuture interface {
-	GetSurge() float64
+	// GetSurge deliberately falls back to no surge if surge could not be calculated.
+	GetSurge(ctx context.Context) float64
 }
 
 type future struct {
-	task async.Task[output]
+	component.Future[output]
 }
 
-func (f future) GetSurge() float64 {
-	r := f.task.ResultOrDefault(output{})
-	return r.surge
+func (f future) GetSurge(ctx context.Context) float64 {
+	return component.ProjectOrDefault(ctx, f.Future, func(o output) float64 { return o.surge }, fallbackSurge)
 }
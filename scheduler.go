@@ -0,0 +1,42 @@
+package component
+
+import "context"
+
+// Scheduler decides how the goroutines of an execution flow get started. It
+// allows a flow to run on a custom pool, on a deterministic scheduler in tests
+// or on an instrumented scheduler that tags its goroutines.
+//
+//go:generate mockery --name Scheduler --case underscore --inpackage
+type Scheduler interface {
+	// Go starts the given fn. The flow context is provided for schedulers to
+	// propagate labels or deadlines. An error means fn will never be executed
+	// and the flow will stop immediately with this error.
+	Go(ctx context.Context, fn func()) error
+}
+
+// GoroutineScheduler starts each fn on a new goroutine. It is
+// the Scheduler used by flows that don't configure any.
+type GoroutineScheduler struct{}
+
+// Go starts the given fn on a new goroutine.
+func (GoroutineScheduler) Go(ctx context.Context, fn func()) error {
+	go fn()
+	return nil
+}
+
+// InlineScheduler executes each fn to completion on the calling goroutine.
+// Executors of a layer get executed one after another in the order they were
+// appended, which makes it useful for deterministic tests.
+//
+// Note: a component must not wait on the future of a component appended after
+// it, otherwise the flow can only complete when its context is done.
+type InlineScheduler struct{}
+
+// Go executes the given fn on the calling goroutine.
+func (InlineScheduler) Go(ctx context.Context, fn func()) error {
+	fn()
+	return nil
+}
+
+// spawnFunc starts the given fn or returns an error.
+type spawnFunc func(fn func()) error
@@ -0,0 +1,162 @@
+package component
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jamestrandung/go-concurrency/v2/async"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type schedulerCtxKey struct{}
+
+func TestScheduler(t *testing.T) {
+	scenarios := []struct {
+		desc string
+		test func(t *testing.T)
+	}{
+		{
+			desc: "inline scheduler executes executors in the order they were appended",
+			test: func(t *testing.T) {
+				var order []int
+
+				newExecutor := func(val int) Executor[int] {
+					return Executor[int]{
+						executingAsyncTask: async.NewTask(
+							func(ctx context.Context) (int, error) {
+								order = append(order, val)
+								return val, nil
+							},
+						),
+					}
+				}
+
+				flow := NewExecutionFlowBuilder().
+					Append(newExecutor(1), newExecutor(2), newExecutor(3)).
+					WithScheduler(InlineScheduler{}).
+					Get()
+
+				actual := ForkJoinFailingFast(context.Background(), flow)
+
+				assert.Nil(t, actual)
+				assert.Equal(t, []int{1, 2, 3}, order)
+			},
+		},
+		{
+			desc: "custom scheduler receives the flow context",
+			test: func(t *testing.T) {
+				ctx := context.WithValue(context.Background(), schedulerCtxKey{}, "flow")
+
+				mockScheduler := NewMockScheduler(t)
+				mockScheduler.On("Go", mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						assert.Equal(t, "flow", args.Get(0).(context.Context).Value(schedulerCtxKey{}))
+						go args.Get(1).(func())()
+					}).
+					Return(nil).
+					Twice()
+
+				e := Executor[int]{
+					executingAsyncTask: async.Completed(1, nil),
+				}
+
+				flow := NewExecutionFlowBuilder().
+					Append(e).
+					WithScheduler(mockScheduler).
+					Get()
+
+				actual := ForkJoinFailingFast(ctx, flow)
+
+				assert.Nil(t, actual)
+			},
+		},
+		{
+			desc: "loading tasks of async executors with loading are started through the scheduler",
+			test: func(t *testing.T) {
+				mockScheduler := NewMockScheduler(t)
+				mockScheduler.On("Go", mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						go args.Get(1).(func())()
+					}).
+					Return(nil).
+					Times(3)
+
+				e, future := CreateAsyncExecutorWithLoadingFromFunc(
+					func(ctx context.Context) (int, error) {
+						return 1, nil
+					},
+					func(ctx context.Context, data LoadData[int]) (int, error) {
+						return data.Data + 1, data.Err
+					},
+				)
+
+				flow := NewExecutionFlowBuilder().
+					Append(e).
+					WithScheduler(mockScheduler).
+					Get()
+
+				actual := ForkJoinFailingFast(context.Background(), flow)
+
+				assert.Nil(t, actual)
+				assert.Equal(t, 2, future.GetOrDefault(context.Background(), 0))
+			},
+		},
+		{
+			desc: "loading tasks never wait behind the executors consuming them",
+			test: func(t *testing.T) {
+				e, future := CreateAsyncExecutorWithLoadingFromFunc(
+					func(ctx context.Context) (int, error) {
+						return 1, nil
+					},
+					func(ctx context.Context, data LoadData[int]) (int, error) {
+						return data.Data + 1, data.Err
+					},
+				)
+
+				flow := NewExecutionFlowBuilder().
+					Append(e).
+					WithMaxConcurrency(1).
+					Get()
+
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+
+				actual := ForkJoinFailingFast(ctx, flow)
+
+				assert.Nil(t, actual)
+				assert.Equal(t, 2, future.GetOrDefault(context.Background(), 0))
+			},
+		},
+		{
+			desc: "error from scheduler fails the flow",
+			test: func(t *testing.T) {
+				mockScheduler := NewMockScheduler(t)
+				mockScheduler.On("Go", mock.Anything, mock.Anything).
+					Return(assert.AnError)
+
+				e := ExecutorWithLoading[int, int]{
+					loadingTask:       async.NewTask(func(ctx context.Context) (int, error) { return 1, nil }),
+					executingSyncTask: async.NewTask(func(ctx context.Context) (int, error) { return 1, nil }),
+				}
+
+				flow := NewExecutionFlowBuilder().
+					Append(e).
+					WithScheduler(mockScheduler).
+					Get()
+
+				actual := ForkJoinFailingFast(context.Background(), flow)
+
+				assert.Equal(t, assert.AnError, actual)
+				assert.Equal(t, async.IsCancelled, e.executingSyncTask.State(), "tasks must be cancelled when they cannot be scheduled")
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(sc.desc, sc.test)
+	}
+}
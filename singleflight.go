@@ -0,0 +1,157 @@
+package component
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+)
+
+// SingleFlightGroup deduplicates identical in-flight calls across concurrent
+// execution flows. The first caller of a key starts the call while the others
+// block & wait for its result.
+//
+// Each caller can still give up waiting using its own context. The shared call
+// only gets cancelled when every caller waiting for it has given up.
+type SingleFlightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+type flight struct {
+	done    chan struct{}
+	result  any
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// NewSingleFlightGroup returns a new SingleFlightGroup.
+func NewSingleFlightGroup() *SingleFlightGroup {
+	return &SingleFlightGroup{
+		flights: make(map[string]*flight),
+	}
+}
+
+// InFlight returns the number of calls that are currently in flight.
+func (g *SingleFlightGroup) InFlight() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return len(g.flights)
+}
+
+func (g *SingleFlightGroup) do(ctx context.Context, key string, fn func(context.Context) (any, error)) (any, error) {
+	g.mu.Lock()
+
+	f, ok := g.flights[key]
+	if !ok {
+		// The shared call must not be tied to the cancellation of
+		// the first caller since other callers may still need it.
+		flightCtx, cancel := context.WithCancel(sharedContext(ctx))
+
+		f = &flight{
+			done:   make(chan struct{}),
+			cancel: cancel,
+		}
+		g.flights[key] = f
+
+		go g.fly(flightCtx, key, f, fn)
+	}
+
+	f.waiters = f.waiters + 1
+
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.result, f.err
+	case <-ctx.Done():
+		g.leave(key, f)
+
+		return nil, ctx.Err()
+	}
+}
+
+func (g *SingleFlightGroup) fly(ctx context.Context, key string, f *flight, fn func(context.Context) (any, error)) {
+	defer f.cancel()
+
+	defer func() {
+		if r := recover(); r != nil {
+			f.err = fmt.Errorf("panic executing single-flight call: %v \n %s", r, debug.Stack())
+		}
+
+		g.forget(key, f)
+		close(f.done)
+	}()
+
+	f.result, f.err = fn(ctx)
+}
+
+// leave removes a waiter from the given flight and cancels
+// the shared call if nobody is waiting for it anymore.
+func (g *SingleFlightGroup) leave(key string, f *flight) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	f.waiters = f.waiters - 1
+	if f.waiters > 0 {
+		return
+	}
+
+	// Subsequent callers must start a fresh call instead
+	// of joining the one that is being cancelled.
+	if g.flights[key] == f {
+		delete(g.flights, key)
+	}
+
+	f.cancel()
+}
+
+func (g *SingleFlightGroup) forget(key string, f *flight) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.flights[key] == f {
+		delete(g.flights, key)
+	}
+}
+
+// executeInSingleFlight executes the given fn via the given single-flight group
+// using the given key if there's a group. Otherwise, fn will be executed directly.
+func executeInSingleFlight[T any](ctx context.Context, group *SingleFlightGroup, key string, fn func(context.Context) (T, error)) (T, error) {
+	if group == nil {
+		return fn(ctx)
+	}
+
+	r, err := group.do(
+		ctx,
+		key,
+		func(ctx context.Context) (any, error) {
+			return fn(ctx)
+		},
+	)
+
+	if r == nil {
+		var temp T
+		return temp, err
+	}
+
+	result, ok := r.(T)
+	if !ok {
+		var temp T
+		return temp, fmt.Errorf("single-flight key %s is shared by calls returning %T and %T", key, r, temp)
+	}
+
+	return result, err
+}
+
+// sharedContext returns the context of a call shared by several callers, which keeps the
+// values of the given context of the first caller. It is not cancelled with this context
+// & does not carry the values tying it to the executor & flow run of the first caller,
+// e.g. the scope, logger & span of this executor, since the call serves all callers.
+func sharedContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(withoutFlowScope(ctx), loggerKey{}, nil)
+
+	return context.WithoutCancel(ctx)
+}
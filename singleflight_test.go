@@ -0,0 +1,258 @@
+package component
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSingleFlightGroup_Do(t *testing.T) {
+	scenarios := []struct {
+		desc string
+		test func(t *testing.T)
+	}{
+		{
+			desc: "concurrent callers share the same in-flight call",
+			test: func(t *testing.T) {
+				g := NewSingleFlightGroup()
+
+				var calls int32
+				release := make(chan struct{})
+
+				fn := func(ctx context.Context) (any, error) {
+					atomic.AddInt32(&calls, 1)
+					<-release
+					return 1, nil
+				}
+
+				var wg sync.WaitGroup
+				results := make([]any, 5)
+
+				for i := 0; i < len(results); i++ {
+					wg.Add(1)
+
+					idx := i
+					go func() {
+						defer wg.Done()
+
+						results[idx], _ = g.do(context.Background(), "key", fn)
+					}()
+				}
+
+				assert.Eventually(t, func() bool {
+					g.mu.Lock()
+					defer g.mu.Unlock()
+
+					f, ok := g.flights["key"]
+					return ok && f.waiters == len(results)
+				}, time.Second, time.Millisecond)
+
+				close(release)
+				wg.Wait()
+
+				assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+				assert.Equal(t, []any{1, 1, 1, 1, 1}, results)
+				assert.Equal(t, 0, g.InFlight())
+			},
+		},
+		{
+			desc: "a call is made again after the previous one completes",
+			test: func(t *testing.T) {
+				g := NewSingleFlightGroup()
+
+				var calls int32
+				fn := func(ctx context.Context) (any, error) {
+					return atomic.AddInt32(&calls, 1), assert.AnError
+				}
+
+				r1, err1 := g.do(context.Background(), "key", fn)
+				r2, err2 := g.do(context.Background(), "key", fn)
+
+				assert.Equal(t, int32(1), r1)
+				assert.Equal(t, assert.AnError, err1)
+				assert.Equal(t, int32(2), r2)
+				assert.Equal(t, assert.AnError, err2)
+			},
+		},
+		{
+			desc: "a caller giving up does not cancel the call shared with others",
+			test: func(t *testing.T) {
+				g := NewSingleFlightGroup()
+
+				release := make(chan struct{})
+				started := make(chan struct{})
+
+				var sharedCtx context.Context
+				fn := func(ctx context.Context) (any, error) {
+					sharedCtx = ctx
+					close(started)
+					<-release
+					return 1, nil
+				}
+
+				ctx, cancel := context.WithCancel(context.Background())
+
+				cancelledErr := make(chan error, 1)
+				go func() {
+					_, err := g.do(ctx, "key", fn)
+					cancelledErr <- err
+				}()
+
+				<-started
+
+				result := make(chan any, 1)
+				go func() {
+					r, _ := g.do(context.Background(), "key", fn)
+					result <- r
+				}()
+
+				assert.Eventually(t, func() bool {
+					g.mu.Lock()
+					defer g.mu.Unlock()
+
+					return g.flights["key"].waiters == 2
+				}, time.Second, time.Millisecond)
+
+				cancel()
+
+				assert.Equal(t, context.Canceled, <-cancelledErr)
+				assert.Nil(t, sharedCtx.Err(), "shared call must stay alive while another caller is waiting")
+
+				close(release)
+
+				assert.Equal(t, 1, <-result)
+			},
+		},
+		{
+			desc: "the shared call is cancelled when every caller gives up",
+			test: func(t *testing.T) {
+				g := NewSingleFlightGroup()
+
+				started := make(chan struct{})
+				fn := func(ctx context.Context) (any, error) {
+					close(started)
+					<-ctx.Done()
+					return nil, ctx.Err()
+				}
+
+				ctx, cancel := context.WithCancel(context.Background())
+
+				errChan := make(chan error, 1)
+				go func() {
+					_, err := g.do(ctx, "key", fn)
+					errChan <- err
+				}()
+
+				<-started
+				cancel()
+
+				assert.Equal(t, context.Canceled, <-errChan)
+				assert.Equal(t, 0, g.InFlight())
+			},
+		},
+		{
+			desc: "the shared call is not tied to the executor of the first caller",
+			test: func(t *testing.T) {
+				type key struct{}
+
+				g := NewSingleFlightGroup()
+
+				ctx := context.WithValue(context.Background(), key{}, "value")
+				ctx = context.WithValue(ctx, invocationScopeKey{}, invocationScope{})
+				ctx = withLogger(ctx, slog.New(slog.NewTextHandler(io.Discard, nil)))
+				ctx = trace.ContextWithSpanContext(
+					ctx,
+					trace.NewSpanContext(
+						trace.SpanContextConfig{
+							TraceID: trace.TraceID{1},
+							SpanID:  trace.SpanID{1},
+						},
+					),
+				)
+
+				result, err := g.do(
+					ctx, "key", func(ctx context.Context) (any, error) {
+						_, hasScope := invocationScopeFrom(ctx)
+
+						return []any{
+							ctx.Value(key{}),
+							hasScope,
+							LoggerFrom(ctx) == slog.Default(),
+							trace.SpanContextFromContext(ctx).IsValid(),
+						}, nil
+					},
+				)
+
+				assert.Nil(t, err)
+				assert.Equal(t, []any{"value", false, true, false}, result)
+			},
+		},
+		{
+			desc: "panic is converted into an error",
+			test: func(t *testing.T) {
+				g := NewSingleFlightGroup()
+
+				_, err := g.do(context.Background(), "key", func(ctx context.Context) (any, error) {
+					panic("boom")
+				})
+
+				assert.ErrorContains(t, err, "panic executing single-flight call: boom")
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(sc.desc, sc.test)
+	}
+}
+
+func TestExecuteInSingleFlight(t *testing.T) {
+	t.Run("no group configured", func(t *testing.T) {
+		result, err := executeInSingleFlight(
+			context.Background(),
+			nil,
+			"key",
+			func(ctx context.Context) (int, error) {
+				return 1, assert.AnError
+			},
+		)
+
+		assert.Equal(t, 1, result)
+		assert.Equal(t, assert.AnError, err)
+	})
+
+	t.Run("key shared by different result types", func(t *testing.T) {
+		group := NewSingleFlightGroup()
+
+		group.mu.Lock()
+
+		done := make(chan struct{})
+		close(done)
+		group.flights["key"] = &flight{
+			done:   done,
+			result: "string",
+			cancel: func() {},
+		}
+
+		group.mu.Unlock()
+
+		_, err := executeInSingleFlight(
+			context.Background(),
+			group,
+			"key",
+			func(ctx context.Context) (int, error) {
+				return 1, nil
+			},
+		)
+
+		assert.ErrorContains(t, err, "single-flight key key is shared by calls returning string and int")
+	})
+}
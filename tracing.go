@@ -0,0 +1,110 @@
+package component
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/jamestrandung/go-component"
+
+// Span names & attributes used by the built-in OpenTelemetry instrumentation.
+const (
+	SpanNameFlow  = "component.flow"
+	SpanNameLayer = "component.layer"
+
+	AttributeLayerCount    = attribute.Key("component.layer.count")
+	AttributeLayerIdx      = attribute.Key("component.layer.index")
+	AttributeExecutorIdx   = attribute.Key("component.executor.index")
+	AttributeExecutorKind  = attribute.Key("component.executor.kind")
+	AttributeExecutorName  = attribute.Key("component.executor.name")
+	AttributeComponentType = attribute.Key("component.type")
+	AttributePhase         = attribute.Key("component.phase")
+	AttributeCancelCause   = attribute.Key("component.cancel.cause")
+)
+
+// Phase spans are named after their phase, e.g. component.load
+const spanNamePrefixForPhase = "component."
+
+// newTracer returns the tracer of the given provider, or nil if the flow has no provider.
+func newTracer(provider trace.TracerProvider) trace.Tracer {
+	if provider == nil {
+		return nil
+	}
+
+	return provider.Tracer(instrumentationName)
+}
+
+// noSpan is ended in place of the flow & layer spans of flows without a tracer provider.
+var noSpan = trace.SpanFromContext(context.Background())
+
+func (r *flowRun) startFlowSpan(ctx context.Context) (context.Context, trace.Span) {
+	if r.tracer == nil {
+		return ctx, noSpan
+	}
+
+	return r.tracer.Start(
+		ctx,
+		SpanNameFlow,
+		trace.WithAttributes(
+			AttributeLayerCount.Int(len(r.flow.Executors)),
+		),
+	)
+}
+
+func (r *flowRun) startLayerSpan(ctx context.Context, layerIdx int) (context.Context, trace.Span) {
+	if r.tracer == nil {
+		return ctx, noSpan
+	}
+
+	return r.tracer.Start(
+		ctx,
+		SpanNameLayer,
+		trace.WithAttributes(
+			AttributeLayerIdx.Int(layerIdx),
+		),
+	)
+}
+
+// tracingInterceptor opens a span around each executor phase so that
+// the downstream calls made by components nest under this span.
+func (r *flowRun) tracingInterceptor() Interceptor {
+	return func(ctx context.Context, inv Invocation, next Handler) (any, error) {
+		ctx, span := r.tracer.Start(
+			ctx,
+			spanNamePrefixForPhase+string(inv.Phase),
+			trace.WithAttributes(
+				AttributeLayerIdx.Int(inv.LayerIdx),
+				AttributeExecutorIdx.Int(inv.ExecutorIdx),
+				AttributeExecutorKind.String(string(inv.Kind)),
+				AttributeExecutorName.String(inv.Executor.Name()),
+				AttributeComponentType.String(inv.Executor.ComponentType()),
+				AttributePhase.String(string(inv.Phase)),
+			),
+		)
+
+		result, err := next(ctx)
+
+		if err != nil && ctx.Err() != nil {
+			if cause := r.cancelCause(ctx); cause != nil {
+				span.SetAttributes(AttributeCancelCause.String(cause.Error()))
+			}
+		}
+
+		endSpan(span, err)
+
+		return result, err
+	}
+}
+
+// endSpan records the given error, if any, on the span before ending it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,188 @@
+package component
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracing(t *testing.T) {
+	scenarios := []struct {
+		desc string
+		test func(t *testing.T)
+	}{
+		{
+			desc: "spans are created for flow, layers and executor phases",
+			test: func(t *testing.T) {
+				recorder := tracetest.NewSpanRecorder()
+				provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+				var componentSpanCtx trace.SpanContext
+
+				mockSyncComponentWithLoading := &MockSyncComponentWithLoading[int, int]{}
+				mockSyncComponentWithLoading.On("Load", contextWithSpan()).Return(1, nil).Once()
+				mockSyncComponentWithLoading.On("ExecuteSync", contextWithSpan(), LoadData[int]{Data: 1}).Return(2, nil).Once()
+
+				asyncExecutor, _ := CreateAsyncExecutor[int](
+					asyncComponentFunc[int](
+						func(ctx context.Context) (int, error) {
+							componentSpanCtx = trace.SpanContextFromContext(ctx)
+							return 1, nil
+						},
+					),
+				)
+
+				flow := NewExecutionFlowBuilder().
+					Append(asyncExecutor).
+					NextLayer().
+					Append(executorOf(CreateSyncExecutorWithLoading[int, int](mockSyncComponentWithLoading))).
+					WithTracerProvider(provider).
+					Get()
+
+				actual := ForkJoinFailingFast(context.Background(), flow)
+				assert.Nil(t, actual)
+
+				spans := spansByName(recorder.Ended())
+
+				flowSpan := spans[SpanNameFlow][0]
+				assert.False(t, flowSpan.Parent().IsValid())
+
+				layerSpans := spans[SpanNameLayer]
+				assert.Equal(t, 2, len(layerSpans))
+				for _, layerSpan := range layerSpans {
+					assert.Equal(t, flowSpan.SpanContext().SpanID(), layerSpan.Parent().SpanID())
+				}
+
+				asyncSpan := spans["component.execute_async"][0]
+				assert.Equal(t, asyncSpan.SpanContext(), componentSpanCtx, "components must receive the span of their phase")
+				assert.Contains(t, asyncSpan.Attributes(), AttributeExecutorKind.String(string(KindAsync)))
+				assert.Contains(t, asyncSpan.Attributes(), AttributeLayerIdx.Int(0))
+
+				loadSpan := spans["component.load"][0]
+				executeSpan := spans["component.execute_sync"][0]
+				assert.Equal(t, loadSpan.Parent().SpanID(), executeSpan.Parent().SpanID(), "load & execute phases must be separate spans of the same layer")
+				assert.Contains(t, loadSpan.Attributes(), AttributeLayerIdx.Int(1))
+
+				mockSyncComponentWithLoading.AssertExpectations(t)
+			},
+		},
+		{
+			desc: "errors and cancellation causes are recorded on spans",
+			test: func(t *testing.T) {
+				recorder := tracetest.NewSpanRecorder()
+				provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+				failingErr := errors.New("error from async task")
+
+				failing, _ := CreateAsyncExecutor[int](
+					asyncComponentFunc[int](
+						func(ctx context.Context) (int, error) {
+							<-time.After(10 * time.Millisecond)
+							return 0, failingErr
+						},
+					),
+				)
+
+				blocking, _ := CreateAsyncExecutor[int](
+					asyncComponentFunc[int](
+						func(ctx context.Context) (int, error) {
+							<-ctx.Done()
+							return 0, ctx.Err()
+						},
+					),
+				)
+
+				flow := NewExecutionFlowBuilder().
+					Append(failing, blocking).
+					WithTracerProvider(provider).
+					Get()
+
+				actual := ForkJoinFailingFast(context.Background(), flow)
+				assert.Equal(t, &ExecutorError{Executor: "component.asyncComponentFunc[int]", Err: failingErr}, actual)
+
+				var spans map[string][]sdktrace.ReadOnlySpan
+				assert.Eventually(t, func() bool {
+					spans = spansByName(recorder.Ended())
+					return len(spans["component.execute_async"]) == 2
+				}, time.Second, time.Millisecond)
+
+				assert.Equal(t, codes.Error, spans[SpanNameFlow][0].Status().Code)
+				assert.Equal(t, actual.Error(), spans[SpanNameFlow][0].Status().Description)
+				assert.Equal(t, codes.Error, spans[SpanNameLayer][0].Status().Code)
+
+				var cancelledSpan sdktrace.ReadOnlySpan
+				for _, s := range spans["component.execute_async"] {
+					assert.Equal(t, codes.Error, s.Status().Code)
+
+					if s.Status().Description == context.Canceled.Error() {
+						cancelledSpan = s
+					}
+				}
+
+				assert.NotNil(t, cancelledSpan)
+				assert.Contains(t, cancelledSpan.Attributes(), AttributeCancelCause.String(actual.Error()))
+			},
+		},
+		{
+			desc: "flows without tracer provider create no spans and keep the span of the caller",
+			test: func(t *testing.T) {
+				recorder := tracetest.NewSpanRecorder()
+				provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+				ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+
+				var actual trace.SpanContext
+				e, _ := CreateSyncOrchestratingExecutorWithResult(func(ctx context.Context) (int, error) {
+					actual = trace.SpanContextFromContext(ctx)
+					return 1, nil
+				})
+
+				flow := NewExecutionFlowBuilder().
+					Append(e).
+					Get()
+
+				assert.Empty(t, newFlowRun(flow, false).interceptors)
+
+				assert.Nil(t, ForkJoinFailingFast(ctx, flow))
+				parent.End()
+
+				assert.Equal(t, parent.SpanContext(), actual)
+				assert.Len(t, recorder.Ended(), 1)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(sc.desc, sc.test)
+	}
+}
+
+type asyncComponentFunc[T any] func(ctx context.Context) (T, error)
+
+func (fn asyncComponentFunc[T]) Execute(ctx context.Context) (T, error) {
+	return fn(ctx)
+}
+
+func contextWithSpan() interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		return trace.SpanContextFromContext(ctx).IsValid()
+	})
+}
+
+func spansByName(spans []sdktrace.ReadOnlySpan) map[string][]sdktrace.ReadOnlySpan {
+	result := make(map[string][]sdktrace.ReadOnlySpan)
+	for _, s := range spans {
+		result[s.Name()] = append(result[s.Name()], s)
+	}
+
+	return result
+}
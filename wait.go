@@ -0,0 +1,234 @@
+package component
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jamestrandung/go-concurrency/v2/async"
+)
+
+// ErrWaitAbandoned is returned when a component stops waiting for the
+// result of another executor because its own context is done.
+var ErrWaitAbandoned = errors.New("wait for executor abandoned")
+
+// waitRecord describes a phase being blocked while waiting for the task of another phase to complete.
+type waitRecord struct {
+	consumer  phaseKey
+	producer  phaseKey
+	startedAt time.Time
+	endedAt   time.Time
+}
+
+// Await waits for the given task to complete and returns its final result & error, like
+// task.Outcome(). If the task got cancelled, the error wraps ErrCancelled. When ctx is the
+// context given to a component by an execution flow and the task belongs to another executor
+// in this flow, the time spent blocked is recorded so that it can be taken into account when
+// analyzing the execution of the flow.
+//
+// Futures should use Await, instead of calling Outcome or ResultOrDefault on the underlying task,
+// to give visibility into how components wait for each other. The time each component spends
+// blocked is attributed to the executor it was waiting for in ExecutionReport & MetricsCollector.
+//
+// If ctx is done before the task completes, Await gives up waiting and returns an error wrapping
+// both ErrWaitAbandoned & the cause of ctx. The wait also ends when the task gets cancelled, e.g.
+// by ExecutionFlow.Cancel, in which case the cancellation error of the task is returned.
+func Await[T any](ctx context.Context, task async.Task[T]) (T, error) {
+	return await(
+		ctx, task, func() <-chan struct{} {
+			return doneOf(task)
+		},
+	)
+}
+
+// await works like Await, done is called only if the task must be watched
+// and returns a channel that gets closed once the task terminates.
+func await[T any](ctx context.Context, task async.Task[T], done func() <-chan struct{}) (T, error) {
+	if isTerminated(task) {
+		return outcomeOf(task)
+	}
+
+	s, ok := invocationScopeFrom(ctx)
+	if !ok {
+		return awaitOutcome(ctx, task, done)
+	}
+
+	startedAt := time.Now()
+	result, err := awaitOutcome(ctx, task, done)
+
+	s.run.recordWait(s.key(), task, startedAt, time.Now())
+
+	return result, err
+}
+
+func awaitOutcome[T any](ctx context.Context, task async.Task[T], done func() <-chan struct{}) (T, error) {
+	// Contexts that can never be done do not need watching
+	if ctx.Done() == nil {
+		return outcomeOf(task)
+	}
+
+	select {
+	case <-done():
+		return outcomeOf(task)
+	case <-ctx.Done():
+		var zero T
+		return zero, abandoned(ctx)
+	}
+}
+
+// abandoned returns the error of a wait abandoned because the given ctx is done.
+func abandoned(ctx context.Context) error {
+	return fmt.Errorf("%w: %w", ErrWaitAbandoned, context.Cause(ctx))
+}
+
+// awaitDependencies waits for the executing tasks of the given executors, declared via DependsOn
+// by the executor being invoked in ctx, to complete. Executors that are not part of the same flow
+// are not waited for. It returns an error only if ctx is done before these executors complete.
+func awaitDependencies(ctx context.Context, dependencies []IExecutor) error {
+	s, ok := invocationScopeFrom(ctx)
+	if !ok {
+		return nil
+	}
+
+	for _, d := range dependencies {
+		task, ok := d.phaseTasks()[executingPhaseOf(d)].(async.SilentTask)
+		if !ok {
+			continue
+		}
+
+		if _, ok := s.run.producerOf(task); !ok {
+			continue
+		}
+
+		if err := awaitTermination(ctx, task); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// awaitTermination works like Await for tasks whose outcome is not needed. It
+// returns an error only if ctx is done before the given task terminates.
+func awaitTermination(ctx context.Context, task async.SilentTask) error {
+	if isTerminated(task) {
+		return nil
+	}
+
+	startedAt := time.Now()
+
+	var err error
+	if ctx.Done() == nil {
+		task.Wait()
+	} else {
+		select {
+		case <-doneOf(task):
+		case <-ctx.Done():
+			err = abandoned(ctx)
+		}
+	}
+
+	if s, ok := invocationScopeFrom(ctx); ok {
+		s.run.recordWait(s.key(), task, startedAt, time.Now())
+	}
+
+	return err
+}
+
+// doneChannels holds the channels returned by doneOf for the tasks being watched.
+var doneChannels sync.Map
+
+// doneOf returns a channel that gets closed once the given task terminates. All
+// waits for the same task share one channel & the goroutine watching this task,
+// so that abandoned waits do not leave goroutines behind.
+func doneOf(task async.SilentTask) <-chan struct{} {
+	if done, ok := doneChannels.Load(task); ok {
+		return done.(chan struct{})
+	}
+
+	done, loaded := doneChannels.LoadOrStore(task, make(chan struct{}))
+	if !loaded {
+		go func() {
+			task.Wait()
+			close(done.(chan struct{}))
+			doneChannels.Delete(task)
+		}()
+	}
+
+	return done.(chan struct{})
+}
+
+// AwaitOrDefault works like Await but returns the given default result if the task returned an
+// error, exactly like task.ResultOrDefault(), or if the wait was abandoned.
+func AwaitOrDefault[T any](ctx context.Context, task async.Task[T], defaultResult T) T {
+	result, err := Await(ctx, task)
+	if err != nil {
+		return defaultResult
+	}
+
+	return result
+}
+
+// isTerminated returns whether the given task has already completed or got cancelled.
+func isTerminated(task async.SilentTask) bool {
+	state := task.State()
+	return state == async.IsCompleted || state == async.IsCancelled
+}
+
+// recordWait records the given consumer phase waiting for the given task if the
+// task belongs to an executor in this run. Other waits are not of interest.
+func (r *flowRun) recordWait(consumer phaseKey, task any, startedAt time.Time, endedAt time.Time) {
+	producer, ok := r.producerOf(task)
+	if !ok {
+		return
+	}
+
+	w := waitRecord{
+		consumer:  consumer,
+		producer:  producer,
+		startedAt: startedAt,
+		endedAt:   endedAt,
+	}
+
+	if r.recording {
+		r.recorder.recordWait(w)
+	}
+
+	if r.flow.metrics != nil {
+		r.flow.metrics.observeWait(r.flow.name, r.executorAt(consumer).Name(), r.executorAt(producer).Name(), w)
+	}
+}
+
+func (r *flowRun) executorAt(key phaseKey) IExecutor {
+	return r.flow.Executors[key.layerIdx][key.executorIdx]
+}
+
+// producerOf returns the phase of the executor in this run that the given task belongs to.
+func (r *flowRun) producerOf(task any) (phaseKey, bool) {
+	r.producersOnce.Do(
+		func() {
+			r.producers = make(map[any]phaseKey)
+
+			for layerIdx, executors := range r.flow.Executors {
+				for executorIdx, e := range executors {
+					for phase, t := range e.phaseTasks() {
+						if t == nil {
+							continue
+						}
+
+						r.producers[t] = phaseKey{
+							layerIdx:    layerIdx,
+							executorIdx: executorIdx,
+							phase:       phase,
+						}
+					}
+				}
+			}
+		},
+	)
+
+	key, ok := r.producers[task]
+	return key, ok
+}
@@ -0,0 +1,60 @@
+package component
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAwait(t *testing.T) {
+	e, _ := CreateAsyncExecutor[int](
+		asyncComponentFunc[int](
+			func(ctx context.Context) (int, error) {
+				return 1, assert.AnError
+			},
+		),
+	)
+
+	go e.InvokeExecutingTask(context.Background())
+
+	actual, err := Await(context.Background(), e.GetExecutingTask())
+	assert.Equal(t, 1, actual)
+	assert.Equal(t, assert.AnError, err)
+}
+
+func TestDoneOf(t *testing.T) {
+	e, _ := CreateAsyncExecutor[int](&MockAsyncComponent[int]{})
+	task := e.GetExecutingTask()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	goroutines := runtime.NumGoroutine()
+	for i := 0; i < 100; i++ {
+		_, err := Await(ctx, task)
+		assert.ErrorIs(t, err, ErrWaitAbandoned)
+		assert.ErrorIs(t, awaitTermination(ctx, task), ErrWaitAbandoned)
+	}
+
+	assert.True(t, runtime.NumGoroutine() <= goroutines+1, "abandoned waits share 1 goroutine watching the task")
+	assert.Equal(t, doneOf(task), doneOf(task))
+
+	done := doneOf(task)
+	task.Cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		assert.Fail(t, "channel is not closed after the task terminated")
+	}
+
+	assert.Eventually(
+		t, func() bool {
+			_, ok := doneChannels.Load(task)
+			return !ok
+		}, time.Second, time.Millisecond,
+	)
+}
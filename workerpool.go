@@ -0,0 +1,212 @@
+package component
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	defaultWorkerPoolMaxWorkers   = 1024
+	defaultWorkerPoolMaxQueueSize = 1024
+)
+
+// ErrWorkerPoolSaturated is returned when all workers of a WorkerPool are
+// busy and its waiting queue is full. The flow will stop immediately.
+var ErrWorkerPoolSaturated = errors.New("worker pool is saturated")
+
+type workerPoolConfigs struct {
+	maxWorkers   int
+	maxQueueSize int
+}
+
+// WorkerPoolOption customizes a WorkerPool when it gets created.
+type WorkerPoolOption func(*workerPoolConfigs)
+
+// WithMaxWorkers sets the maximum number of goroutines that the pool can run
+// concurrently. If `maxWorkers <= 0`, the default value will be used.
+func WithMaxWorkers(maxWorkers int) WorkerPoolOption {
+	return func(configs *workerPoolConfigs) {
+		if maxWorkers <= 0 {
+			configs.maxWorkers = defaultWorkerPoolMaxWorkers
+			return
+		}
+
+		configs.maxWorkers = maxWorkers
+	}
+}
+
+// WithMaxQueueSize sets the maximum number of jobs that can wait for a free
+// worker. Beyond this limit, ErrWorkerPoolSaturated will be returned. If
+// `maxQueueSize < 0`, the default value will be used.
+func WithMaxQueueSize(maxQueueSize int) WorkerPoolOption {
+	return func(configs *workerPoolConfigs) {
+		if maxQueueSize < 0 {
+			configs.maxQueueSize = defaultWorkerPoolMaxQueueSize
+			return
+		}
+
+		configs.maxQueueSize = maxQueueSize
+	}
+}
+
+// WorkerPoolStats is a snapshot of the queueing metrics of a WorkerPool.
+type WorkerPoolStats struct {
+	RunningWorkers int
+	QueuedJobs     int
+	SubmittedJobs  uint64
+	RejectedJobs   uint64
+	TotalQueueWait time.Duration
+}
+
+// WorkerPool is a Scheduler backed by a pool of goroutines shared by execution
+// flows so that the total number of goroutines executing components stays
+// bounded regardless of the incoming traffic. Workers are spawned on demand
+// and exit as soon as there's no more queued jobs.
+//
+// Note: components blocking & waiting on futures of other components occupy
+// their workers while waiting. The pool must be large enough to run all the
+// dependent components of a flow at the same time, otherwise the flow can
+// only complete when its context is done.
+type WorkerPool struct {
+	*workerPoolConfigs
+	spawner *boundedSpawner
+}
+
+// NewWorkerPool returns a new WorkerPool.
+func NewWorkerPool(options ...WorkerPoolOption) *WorkerPool {
+	configs := &workerPoolConfigs{
+		maxWorkers:   defaultWorkerPoolMaxWorkers,
+		maxQueueSize: defaultWorkerPoolMaxQueueSize,
+	}
+
+	for _, o := range options {
+		o(configs)
+	}
+
+	return &WorkerPool{
+		workerPoolConfigs: configs,
+		spawner: newBoundedSpawner(
+			configs.maxWorkers,
+			configs.maxQueueSize,
+			func(fn func()) error {
+				go fn()
+				return nil
+			},
+		),
+	}
+}
+
+// Stats returns the current queueing metrics of this pool.
+func (p *WorkerPool) Stats() WorkerPoolStats {
+	return p.spawner.stats()
+}
+
+// Go runs the given fn on a worker of this pool or returns ErrWorkerPoolSaturated
+// if all workers are busy and the waiting queue is full.
+func (p *WorkerPool) Go(ctx context.Context, fn func()) error {
+	return p.spawner.submit(fn)
+}
+
+type queuedJob struct {
+	fn         func()
+	enqueuedAt time.Time
+}
+
+// boundedSpawner runs at most `limit` jobs concurrently using `next`.
+// Jobs beyond this limit are queued & get executed on the goroutines
+// of running jobs once they complete.
+type boundedSpawner struct {
+	mu             sync.Mutex
+	limit          int
+	maxQueueSize   int // negative means unbounded
+	next           spawnFunc
+	running        int
+	queue          []queuedJob
+	submitted      uint64
+	rejected       uint64
+	totalQueueWait time.Duration
+}
+
+func newBoundedSpawner(limit int, maxQueueSize int, next spawnFunc) *boundedSpawner {
+	return &boundedSpawner{
+		limit:        limit,
+		maxQueueSize: maxQueueSize,
+		next:         next,
+	}
+}
+
+func (s *boundedSpawner) submit(fn func()) error {
+	s.mu.Lock()
+
+	if s.running < s.limit {
+		s.running = s.running + 1
+		s.submitted = s.submitted + 1
+		s.mu.Unlock()
+
+		if err := s.next(func() { s.work(fn) }); err != nil {
+			s.mu.Lock()
+			s.running = s.running - 1
+			s.submitted = s.submitted - 1
+			s.rejected = s.rejected + 1
+			s.mu.Unlock()
+
+			return err
+		}
+
+		return nil
+	}
+
+	defer s.mu.Unlock()
+
+	if s.maxQueueSize >= 0 && len(s.queue) >= s.maxQueueSize {
+		s.rejected = s.rejected + 1
+		return ErrWorkerPoolSaturated
+	}
+
+	s.submitted = s.submitted + 1
+	s.queue = append(s.queue, queuedJob{
+		fn:         fn,
+		enqueuedAt: time.Now(),
+	})
+
+	return nil
+}
+
+func (s *boundedSpawner) work(fn func()) {
+	for {
+		fn()
+
+		s.mu.Lock()
+
+		if len(s.queue) == 0 {
+			s.running = s.running - 1
+			s.mu.Unlock()
+
+			return
+		}
+
+		job := s.queue[0]
+		s.queue[0] = queuedJob{}
+		s.queue = s.queue[1:]
+		s.totalQueueWait = s.totalQueueWait + time.Since(job.enqueuedAt)
+
+		s.mu.Unlock()
+
+		fn = job.fn
+	}
+}
+
+func (s *boundedSpawner) stats() WorkerPoolStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return WorkerPoolStats{
+		RunningWorkers: s.running,
+		QueuedJobs:     len(s.queue),
+		SubmittedJobs:  s.submitted,
+		RejectedJobs:   s.rejected,
+		TotalQueueWait: s.totalQueueWait,
+	}
+}
@@ -0,0 +1,107 @@
+package component
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerPool(t *testing.T) {
+	scenarios := []struct {
+		desc string
+		test func(t *testing.T)
+	}{
+		{
+			desc: "jobs beyond max workers are queued and run after running jobs complete",
+			test: func(t *testing.T) {
+				p := NewWorkerPool(WithMaxWorkers(1), WithMaxQueueSize(2))
+
+				release := make(chan struct{})
+
+				var mu sync.Mutex
+				var order []int
+
+				var wg sync.WaitGroup
+				wg.Add(3)
+
+				for i := 0; i < 3; i++ {
+					idx := i
+					err := p.Go(context.Background(), func() {
+						defer wg.Done()
+
+						if idx == 0 {
+							<-release
+						}
+
+						mu.Lock()
+						defer mu.Unlock()
+
+						order = append(order, idx)
+					})
+
+					assert.Nil(t, err)
+				}
+
+				stats := p.Stats()
+				assert.Equal(t, 1, stats.RunningWorkers)
+				assert.Equal(t, 2, stats.QueuedJobs)
+				assert.Equal(t, uint64(3), stats.SubmittedJobs)
+
+				close(release)
+				wg.Wait()
+
+				assert.Equal(t, []int{0, 1, 2}, order, "all jobs must run on the only worker in FIFO order")
+
+				assert.Eventually(t, func() bool {
+					return p.Stats().RunningWorkers == 0
+				}, time.Second, time.Millisecond)
+			},
+		},
+		{
+			desc: "jobs beyond max queue size are rejected",
+			test: func(t *testing.T) {
+				p := NewWorkerPool(WithMaxWorkers(1), WithMaxQueueSize(0))
+
+				release := make(chan struct{})
+				defer close(release)
+
+				assert.Nil(t, p.Go(context.Background(), func() { <-release }))
+				assert.Equal(t, ErrWorkerPoolSaturated, p.Go(context.Background(), func() {}))
+
+				stats := p.Stats()
+				assert.Equal(t, uint64(1), stats.SubmittedJobs)
+				assert.Equal(t, uint64(1), stats.RejectedJobs)
+			},
+		},
+		{
+			desc: "invalid options fall back to default values",
+			test: func(t *testing.T) {
+				p := NewWorkerPool(WithMaxWorkers(0), WithMaxQueueSize(-1))
+
+				assert.Equal(t, defaultWorkerPoolMaxWorkers, p.maxWorkers)
+				assert.Equal(t, defaultWorkerPoolMaxQueueSize, p.maxQueueSize)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		sc := scenario
+		t.Run(sc.desc, sc.test)
+	}
+}
+
+func TestBoundedSpawner_RejectedByNext(t *testing.T) {
+	s := newBoundedSpawner(1, -1, func(fn func()) error {
+		return assert.AnError
+	})
+
+	assert.Equal(t, assert.AnError, s.submit(func() {}))
+
+	stats := s.stats()
+	assert.Equal(t, 0, stats.RunningWorkers)
+	assert.Equal(t, uint64(0), stats.SubmittedJobs)
+	assert.Equal(t, uint64(1), stats.RejectedJobs)
+}